@@ -0,0 +1,15 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// setDefaults set default value of fields.
+func (s *ImportUsersReq) setDefaults() {
+	{
+		val := bool(false)
+		s.DryRun.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.SendInvitations.SetTo(val)
+	}
+}
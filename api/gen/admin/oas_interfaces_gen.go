@@ -1,6 +1,30 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type AcceptInvitationRes interface {
+	acceptInvitationRes()
+}
+
+type BulkUserActionRes interface {
+	bulkUserActionRes()
+}
+
+type ChangePasswordRes interface {
+	changePasswordRes()
+}
+
+type CompleteSsoRes interface {
+	completeSsoRes()
+}
+
+type ConfirmMfaRes interface {
+	confirmMfaRes()
+}
+
+type CreateApiKeyRes interface {
+	createApiKeyRes()
+}
+
 type DeleteTaskRes interface {
 	deleteTaskRes()
 }
@@ -9,6 +33,14 @@ type DeleteUserRes interface {
 	deleteUserRes()
 }
 
+type EnrollMfaRes interface {
+	enrollMfaRes()
+}
+
+type ExportUsersRes interface {
+	exportUsersRes()
+}
+
 type GetChatRes interface {
 	getChatRes()
 }
@@ -17,6 +49,10 @@ type GetCurrentUserRes interface {
 	getCurrentUserRes()
 }
 
+type GetInvitationRes interface {
+	getInvitationRes()
+}
+
 type GetTaskRes interface {
 	getTaskRes()
 }
@@ -25,10 +61,78 @@ type GetUserRes interface {
 	getUserRes()
 }
 
+type ImpersonateUserRes interface {
+	impersonateUserRes()
+}
+
+type ImportUsersRes interface {
+	importUsersRes()
+}
+
+type ListLoginHistoryRes interface {
+	listLoginHistoryRes()
+}
+
+type ListUserSessionsRes interface {
+	listUserSessionsRes()
+}
+
 type LoginRes interface {
 	loginRes()
 }
 
+type PurgeUserRes interface {
+	purgeUserRes()
+}
+
+type RefreshTokenRes interface {
+	refreshTokenRes()
+}
+
+type ResendInvitationRes interface {
+	resendInvitationRes()
+}
+
+type ResetPasswordRes interface {
+	resetPasswordRes()
+}
+
+type ResetUserMfaRes interface {
+	resetUserMfaRes()
+}
+
+type RestoreUserRes interface {
+	restoreUserRes()
+}
+
+type RevokeApiKeyRes interface {
+	revokeApiKeyRes()
+}
+
+type RevokeInvitationRes interface {
+	revokeInvitationRes()
+}
+
+type RevokeUserSessionRes interface {
+	revokeUserSessionRes()
+}
+
+type RevokeUserSessionsRes interface {
+	revokeUserSessionsRes()
+}
+
+type StartSsoRes interface {
+	startSsoRes()
+}
+
+type StopImpersonationRes interface {
+	stopImpersonationRes()
+}
+
+type UnlockUserRes interface {
+	unlockUserRes()
+}
+
 type UpdateTaskRes interface {
 	updateTaskRes()
 }
@@ -36,3 +140,7 @@ type UpdateTaskRes interface {
 type UpdateUserRes interface {
 	updateUserRes()
 }
+
+type VerifyMfaRes interface {
+	verifyMfaRes()
+}
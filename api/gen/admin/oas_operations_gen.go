@@ -6,27 +6,56 @@ package api
 type OperationName = string
 
 const (
+	AcceptInvitationOperation     OperationName = "AcceptInvitation"
+	BulkUserActionOperation       OperationName = "BulkUserAction"
+	ChangePasswordOperation       OperationName = "ChangePassword"
+	CompleteSsoOperation          OperationName = "CompleteSso"
+	ConfirmMfaOperation           OperationName = "ConfirmMfa"
 	ConnectAppOperation           OperationName = "ConnectApp"
+	CreateApiKeyOperation         OperationName = "CreateApiKey"
 	CreateTaskOperation           OperationName = "CreateTask"
 	CreateUserOperation           OperationName = "CreateUser"
 	DeleteTaskOperation           OperationName = "DeleteTask"
 	DeleteUserOperation           OperationName = "DeleteUser"
 	DisconnectAppOperation        OperationName = "DisconnectApp"
+	EnrollMfaOperation            OperationName = "EnrollMfa"
+	ExportUsersOperation          OperationName = "ExportUsers"
+	ForgotPasswordOperation       OperationName = "ForgotPassword"
 	GetChatOperation              OperationName = "GetChat"
 	GetCurrentUserOperation       OperationName = "GetCurrentUser"
 	GetDashboardOverviewOperation OperationName = "GetDashboardOverview"
 	GetDashboardStatsOperation    OperationName = "GetDashboardStats"
+	GetInvitationOperation        OperationName = "GetInvitation"
 	GetRecentSalesOperation       OperationName = "GetRecentSales"
 	GetTaskOperation              OperationName = "GetTask"
 	GetUserOperation              OperationName = "GetUser"
+	ImpersonateUserOperation      OperationName = "ImpersonateUser"
+	ImportUsersOperation          OperationName = "ImportUsers"
 	InviteUserOperation           OperationName = "InviteUser"
+	ListApiKeysOperation          OperationName = "ListApiKeys"
 	ListAppsOperation             OperationName = "ListApps"
 	ListChatsOperation            OperationName = "ListChats"
+	ListLoginHistoryOperation     OperationName = "ListLoginHistory"
 	ListTasksOperation            OperationName = "ListTasks"
+	ListUserSessionsOperation     OperationName = "ListUserSessions"
 	ListUsersOperation            OperationName = "ListUsers"
 	LoginOperation                OperationName = "Login"
 	LogoutOperation               OperationName = "Logout"
+	PurgeUserOperation            OperationName = "PurgeUser"
+	RefreshTokenOperation         OperationName = "RefreshToken"
+	ResendInvitationOperation     OperationName = "ResendInvitation"
+	ResetPasswordOperation        OperationName = "ResetPassword"
+	ResetUserMfaOperation         OperationName = "ResetUserMfa"
+	RestoreUserOperation          OperationName = "RestoreUser"
+	RevokeApiKeyOperation         OperationName = "RevokeApiKey"
+	RevokeInvitationOperation     OperationName = "RevokeInvitation"
+	RevokeUserSessionOperation    OperationName = "RevokeUserSession"
+	RevokeUserSessionsOperation   OperationName = "RevokeUserSessions"
 	SendMessageOperation          OperationName = "SendMessage"
+	StartSsoOperation             OperationName = "StartSso"
+	StopImpersonationOperation    OperationName = "StopImpersonation"
+	UnlockUserOperation           OperationName = "UnlockUser"
 	UpdateTaskOperation           OperationName = "UpdateTask"
 	UpdateUserOperation           OperationName = "UpdateUser"
+	VerifyMfaOperation            OperationName = "VerifyMfa"
 )
@@ -15,24 +15,24 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
-// ConnectAppParams is parameters of connectApp operation.
-type ConnectAppParams struct {
-	AppId string
+// AcceptInvitationParams is parameters of acceptInvitation operation.
+type AcceptInvitationParams struct {
+	Token string
 }
 
-func unpackConnectAppParams(packed middleware.Parameters) (params ConnectAppParams) {
+func unpackAcceptInvitationParams(packed middleware.Parameters) (params AcceptInvitationParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "appId",
+			Name: "token",
 			In:   "path",
 		}
-		params.AppId = packed[key].(string)
+		params.Token = packed[key].(string)
 	}
 	return params
 }
 
-func decodeConnectAppParams(args [1]string, argsEscaped bool, r *http.Request) (params ConnectAppParams, _ error) {
-	// Decode path: appId.
+func decodeAcceptInvitationParams(args [1]string, argsEscaped bool, r *http.Request) (params AcceptInvitationParams, _ error) {
+	// Decode path: token.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -44,7 +44,7 @@ func decodeConnectAppParams(args [1]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "appId",
+				Param:   "token",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -61,7 +61,7 @@ func decodeConnectAppParams(args [1]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				params.AppId = c
+				params.Token = c
 				return nil
 			}(); err != nil {
 				return err
@@ -72,7 +72,7 @@ func decodeConnectAppParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "appId",
+			Name: "token",
 			In:   "path",
 			Err:  err,
 		}
@@ -80,24 +80,24 @@ func decodeConnectAppParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// DeleteTaskParams is parameters of deleteTask operation.
-type DeleteTaskParams struct {
-	TaskId string
+// ConnectAppParams is parameters of connectApp operation.
+type ConnectAppParams struct {
+	AppId string
 }
 
-func unpackDeleteTaskParams(packed middleware.Parameters) (params DeleteTaskParams) {
+func unpackConnectAppParams(packed middleware.Parameters) (params ConnectAppParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "taskId",
+			Name: "appId",
 			In:   "path",
 		}
-		params.TaskId = packed[key].(string)
+		params.AppId = packed[key].(string)
 	}
 	return params
 }
 
-func decodeDeleteTaskParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteTaskParams, _ error) {
-	// Decode path: taskId.
+func decodeConnectAppParams(args [1]string, argsEscaped bool, r *http.Request) (params ConnectAppParams, _ error) {
+	// Decode path: appId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -109,7 +109,7 @@ func decodeDeleteTaskParams(args [1]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "taskId",
+				Param:   "appId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -126,7 +126,7 @@ func decodeDeleteTaskParams(args [1]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				params.TaskId = c
+				params.AppId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -137,7 +137,7 @@ func decodeDeleteTaskParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "taskId",
+			Name: "appId",
 			In:   "path",
 			Err:  err,
 		}
@@ -145,24 +145,37 @@ func decodeDeleteTaskParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// DeleteUserParams is parameters of deleteUser operation.
-type DeleteUserParams struct {
-	UserId string
+// DeleteTaskParams is parameters of deleteTask operation.
+type DeleteTaskParams struct {
+	TaskId string
+	// ETag of the version the change is based on. The request fails with 412 when the record has changed
+	// since; without it the change is applied unconditionally.
+	IfMatch OptString `json:",omitempty,omitzero"`
 }
 
-func unpackDeleteUserParams(packed middleware.Parameters) (params DeleteUserParams) {
+func unpackDeleteTaskParams(packed middleware.Parameters) (params DeleteTaskParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "userId",
+			Name: "taskId",
 			In:   "path",
 		}
-		params.UserId = packed[key].(string)
+		params.TaskId = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
 	}
 	return params
 }
 
-func decodeDeleteUserParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteUserParams, _ error) {
-	// Decode path: userId.
+func decodeDeleteTaskParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteTaskParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: taskId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -174,7 +187,7 @@ func decodeDeleteUserParams(args [1]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "userId",
+				Param:   "taskId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -191,7 +204,7 @@ func decodeDeleteUserParams(args [1]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				params.UserId = c
+				params.TaskId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -202,97 +215,84 @@ func decodeDeleteUserParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "userId",
+			Name: "taskId",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// DisconnectAppParams is parameters of disconnectApp operation.
-type DisconnectAppParams struct {
-	AppId string
-}
-
-func unpackDisconnectAppParams(packed middleware.Parameters) (params DisconnectAppParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "appId",
-			In:   "path",
-		}
-		params.AppId = packed[key].(string)
-	}
-	return params
-}
-
-func decodeDisconnectAppParams(args [1]string, argsEscaped bool, r *http.Request) (params DisconnectAppParams, _ error) {
-	// Decode path: appId.
+	// Decode header: If-Match.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "appId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.AppId = c
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "appId",
-			In:   "path",
+			Name: "If-Match",
+			In:   "header",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetChatParams is parameters of getChat operation.
-type GetChatParams struct {
-	ChatId string
+// DeleteUserParams is parameters of deleteUser operation.
+type DeleteUserParams struct {
+	UserId string
+	// ETag of the version the change is based on. The request fails with 412 when the record has changed
+	// since; without it the change is applied unconditionally.
+	IfMatch OptString `json:",omitempty,omitzero"`
 }
 
-func unpackGetChatParams(packed middleware.Parameters) (params GetChatParams) {
+func unpackDeleteUserParams(packed middleware.Parameters) (params DeleteUserParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "chatId",
+			Name: "userId",
 			In:   "path",
 		}
-		params.ChatId = packed[key].(string)
+		params.UserId = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
 	}
 	return params
 }
 
-func decodeGetChatParams(args [1]string, argsEscaped bool, r *http.Request) (params GetChatParams, _ error) {
-	// Decode path: chatId.
+func decodeDeleteUserParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteUserParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: userId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -304,7 +304,7 @@ func decodeGetChatParams(args [1]string, argsEscaped bool, r *http.Request) (par
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "chatId",
+				Param:   "userId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -321,7 +321,7 @@ func decodeGetChatParams(args [1]string, argsEscaped bool, r *http.Request) (par
 					return err
 				}
 
-				params.ChatId = c
+				params.UserId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -332,97 +332,71 @@ func decodeGetChatParams(args [1]string, argsEscaped bool, r *http.Request) (par
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "chatId",
+			Name: "userId",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetTaskParams is parameters of getTask operation.
-type GetTaskParams struct {
-	TaskId string
-}
-
-func unpackGetTaskParams(packed middleware.Parameters) (params GetTaskParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "taskId",
-			In:   "path",
-		}
-		params.TaskId = packed[key].(string)
-	}
-	return params
-}
-
-func decodeGetTaskParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTaskParams, _ error) {
-	// Decode path: taskId.
+	// Decode header: If-Match.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "taskId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.TaskId = c
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "taskId",
-			In:   "path",
+			Name: "If-Match",
+			In:   "header",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetUserParams is parameters of getUser operation.
-type GetUserParams struct {
-	UserId string
+// DisconnectAppParams is parameters of disconnectApp operation.
+type DisconnectAppParams struct {
+	AppId string
 }
 
-func unpackGetUserParams(packed middleware.Parameters) (params GetUserParams) {
+func unpackDisconnectAppParams(packed middleware.Parameters) (params DisconnectAppParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "userId",
+			Name: "appId",
 			In:   "path",
 		}
-		params.UserId = packed[key].(string)
+		params.AppId = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetUserParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserParams, _ error) {
-	// Decode path: userId.
+func decodeDisconnectAppParams(args [1]string, argsEscaped bool, r *http.Request) (params DisconnectAppParams, _ error) {
+	// Decode path: appId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -434,7 +408,7 @@ func decodeGetUserParams(args [1]string, argsEscaped bool, r *http.Request) (par
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "userId",
+				Param:   "appId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -451,7 +425,7 @@ func decodeGetUserParams(args [1]string, argsEscaped bool, r *http.Request) (par
 					return err
 				}
 
-				params.UserId = c
+				params.AppId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -462,7 +436,7 @@ func decodeGetUserParams(args [1]string, argsEscaped bool, r *http.Request) (par
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "userId",
+			Name: "appId",
 			In:   "path",
 			Err:  err,
 		}
@@ -470,58 +444,73 @@ func decodeGetUserParams(args [1]string, argsEscaped bool, r *http.Request) (par
 	return params, nil
 }
 
-// ListAppsParams is parameters of listApps operation.
-type ListAppsParams struct {
-	Type OptListAppsType `json:",omitempty,omitzero"`
-	// Search filter for app name.
-	Filter OptString       `json:",omitempty,omitzero"`
-	Sort   OptListAppsSort `json:",omitempty,omitzero"`
+// ExportUsersParams is parameters of exportUsers operation.
+type ExportUsersParams struct {
+	Format OptUserExportFormat `json:",omitempty,omitzero"`
+	Status []UserStatus        `json:",omitempty"`
+	Role   []UserRole          `json:",omitempty"`
+	// Filter by username.
+	Username OptString `json:",omitempty,omitzero"`
 }
 
-func unpackListAppsParams(packed middleware.Parameters) (params ListAppsParams) {
+func unpackExportUsersParams(packed middleware.Parameters) (params ExportUsersParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "type",
+			Name: "format",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Type = v.(OptListAppsType)
+			params.Format = v.(OptUserExportFormat)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "filter",
+			Name: "status",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Filter = v.(OptString)
+			params.Status = v.([]UserStatus)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "sort",
+			Name: "role",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Sort = v.(OptListAppsSort)
+			params.Role = v.([]UserRole)
 		}
 	}
-	return params
-}
-
-func decodeListAppsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListAppsParams, _ error) {
+	{
+		key := middleware.ParameterKey{
+			Name: "username",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Username = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeExportUsersParams(args [0]string, argsEscaped bool, r *http.Request) (params ExportUsersParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: type.
+	// Set default value for query: format.
+	{
+		val := UserExportFormat("csv")
+		params.Format.SetTo(val)
+	}
+	// Decode query: format.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "type",
+			Name:    "format",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotTypeVal ListAppsType
+				var paramsDotFormatVal UserExportFormat
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -533,18 +522,18 @@ func decodeListAppsParams(args [0]string, argsEscaped bool, r *http.Request) (pa
 						return err
 					}
 
-					paramsDotTypeVal = ListAppsType(c)
+					paramsDotFormatVal = UserExportFormat(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Type.SetTo(paramsDotTypeVal)
+				params.Format.SetTo(paramsDotFormatVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Type.Get(); ok {
+				if value, ok := params.Format.Get(); ok {
 					if err := func() error {
 						if err := value.Validate(); err != nil {
 							return err
@@ -562,95 +551,128 @@ func decodeListAppsParams(args [0]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "type",
+			Name: "format",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: filter.
+	// Decode query: status.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "filter",
+			Name:    "status",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotFilterVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotStatusVal UserStatus
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
 
-					c, err := conv.ToString(val)
-					if err != nil {
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotStatusVal = UserStatus(c)
+						return nil
+					}(); err != nil {
 						return err
 					}
-
-					paramsDotFilterVal = c
+					params.Status = append(params.Status, paramsDotStatusVal)
 					return nil
-				}(); err != nil {
-					return err
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.Status {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
 				}
-				params.Filter.SetTo(paramsDotFilterVal)
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "filter",
+			Name: "status",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: sort.
+	// Decode query: role.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sort",
+			Name:    "role",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSortVal ListAppsSort
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotRoleVal UserRole
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
 
-					c, err := conv.ToString(val)
-					if err != nil {
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotRoleVal = UserRole(c)
+						return nil
+					}(); err != nil {
 						return err
 					}
-
-					paramsDotSortVal = ListAppsSort(c)
+					params.Role = append(params.Role, paramsDotRoleVal)
 					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Sort.SetTo(paramsDotSortVal)
-				return nil
+				})
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Sort.Get(); ok {
+				var failures []validate.FieldError
+				for i, elem := range params.Role {
 					if err := func() error {
-						if err := value.Validate(); err != nil {
+						if err := elem.Validate(); err != nil {
 							return err
 						}
 						return nil
 					}(); err != nil {
-						return err
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
 					}
 				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
 				return nil
 			}(); err != nil {
 				return err
@@ -659,46 +681,22 @@ func decodeListAppsParams(args [0]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sort",
+			Name: "role",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// ListChatsParams is parameters of listChats operation.
-type ListChatsParams struct {
-	// Search by user name.
-	Search OptString `json:",omitempty,omitzero"`
-}
-
-func unpackListChatsParams(packed middleware.Parameters) (params ListChatsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "search",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Search = v.(OptString)
-		}
-	}
-	return params
-}
-
-func decodeListChatsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListChatsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: search.
+	// Decode query: username.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "search",
+			Name:    "username",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSearchVal string
+				var paramsDotUsernameVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -710,12 +708,12 @@ func decodeListChatsParams(args [0]string, argsEscaped bool, r *http.Request) (p
 						return err
 					}
 
-					paramsDotSearchVal = c
+					paramsDotUsernameVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Search.SetTo(paramsDotSearchVal)
+				params.Username.SetTo(paramsDotUsernameVal)
 				return nil
 			}); err != nil {
 				return err
@@ -724,7 +722,7 @@ func decodeListChatsParams(args [0]string, argsEscaped bool, r *http.Request) (p
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "search",
+			Name: "username",
 			In:   "query",
 			Err:  err,
 		}
@@ -732,146 +730,308 @@ func decodeListChatsParams(args [0]string, argsEscaped bool, r *http.Request) (p
 	return params, nil
 }
 
-// ListTasksParams is parameters of listTasks operation.
-type ListTasksParams struct {
-	Page     OptInt         `json:",omitempty,omitzero"`
-	PageSize OptInt         `json:",omitempty,omitzero"`
-	Status   []TaskStatus   `json:",omitempty"`
-	Priority []TaskPriority `json:",omitempty"`
-	// Search filter for title or ID.
-	Filter OptString `json:",omitempty,omitzero"`
+// GetChatParams is parameters of getChat operation.
+type GetChatParams struct {
+	ChatId string
 }
 
-func unpackListTasksParams(packed middleware.Parameters) (params ListTasksParams) {
+func unpackGetChatParams(packed middleware.Parameters) (params GetChatParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "page",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Page = v.(OptInt)
+			Name: "chatId",
+			In:   "path",
 		}
+		params.ChatId = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "pageSize",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.PageSize = v.(OptInt)
+	return params
+}
+
+func decodeGetChatParams(args [1]string, argsEscaped bool, r *http.Request) (params GetChatParams, _ error) {
+	// Decode path: chatId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "status",
-			In:   "query",
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "chatId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ChatId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
 		}
-		if v, ok := packed[key]; ok {
-			params.Status = v.([]TaskStatus)
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "chatId",
+			In:   "path",
+			Err:  err,
 		}
 	}
+	return params, nil
+}
+
+// GetInvitationParams is parameters of getInvitation operation.
+type GetInvitationParams struct {
+	Token string
+}
+
+func unpackGetInvitationParams(packed middleware.Parameters) (params GetInvitationParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "priority",
-			In:   "query",
+			Name: "token",
+			In:   "path",
 		}
-		if v, ok := packed[key]; ok {
-			params.Priority = v.([]TaskPriority)
+		params.Token = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetInvitationParams(args [1]string, argsEscaped bool, r *http.Request) (params GetInvitationParams, _ error) {
+	// Decode path: token.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "token",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Token = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "token",
+			In:   "path",
+			Err:  err,
 		}
 	}
+	return params, nil
+}
+
+// GetTaskParams is parameters of getTask operation.
+type GetTaskParams struct {
+	TaskId string
+}
+
+func unpackGetTaskParams(packed middleware.Parameters) (params GetTaskParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "filter",
+			Name: "taskId",
+			In:   "path",
+		}
+		params.TaskId = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetTaskParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTaskParams, _ error) {
+	// Decode path: taskId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "taskId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TaskId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "taskId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetUserParams is parameters of getUser operation.
+type GetUserParams struct {
+	UserId string
+	// Also return deleted users. Only admins may set this.
+	IncludeDeleted OptBool `json:",omitempty,omitzero"`
+}
+
+func unpackGetUserParams(packed middleware.Parameters) (params GetUserParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "includeDeleted",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Filter = v.(OptString)
+			params.IncludeDeleted = v.(OptBool)
 		}
 	}
 	return params
 }
 
-func decodeListTasksParams(args [0]string, argsEscaped bool, r *http.Request) (params ListTasksParams, _ error) {
+func decodeGetUserParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: page.
-	{
-		val := int(1)
-		params.Page.SetTo(val)
-	}
-	// Decode query: page.
+	// Decode path: userId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "page",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPageVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotPageVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Page.SetTo(paramsDotPageVal)
+
+				params.UserId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "page",
-			In:   "query",
+			Name: "userId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: pageSize.
+	// Set default value for query: includeDeleted.
 	{
-		val := int(10)
-		params.PageSize.SetTo(val)
+		val := bool(false)
+		params.IncludeDeleted.SetTo(val)
 	}
-	// Decode query: pageSize.
+	// Decode query: includeDeleted.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "pageSize",
+			Name:    "includeDeleted",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPageSizeVal int
+				var paramsDotIncludeDeletedVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToBool(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotPageSizeVal = c
+					paramsDotIncludeDeletedVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.PageSize.SetTo(paramsDotPageSizeVal)
+				params.IncludeDeleted.SetTo(paramsDotIncludeDeletedVal)
 				return nil
 			}); err != nil {
 				return err
@@ -880,63 +1040,163 @@ func decodeListTasksParams(args [0]string, argsEscaped bool, r *http.Request) (p
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "pageSize",
+			Name: "includeDeleted",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: status.
+	return params, nil
+}
+
+// ImpersonateUserParams is parameters of impersonateUser operation.
+type ImpersonateUserParams struct {
+	UserId string
+}
+
+func unpackImpersonateUserParams(packed middleware.Parameters) (params ImpersonateUserParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(string)
+	}
+	return params
+}
+
+func decodeImpersonateUserParams(args [1]string, argsEscaped bool, r *http.Request) (params ImpersonateUserParams, _ error) {
+	// Decode path: userId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "status",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotStatusVal TaskStatus
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
 
-						paramsDotStatusVal = TaskStatus(c)
-						return nil
-					}(); err != nil {
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListAppsParams is parameters of listApps operation.
+type ListAppsParams struct {
+	Type OptListAppsType `json:",omitempty,omitzero"`
+	// Search filter for app name.
+	Filter OptString       `json:",omitempty,omitzero"`
+	Sort   OptListAppsSort `json:",omitempty,omitzero"`
+}
+
+func unpackListAppsParams(packed middleware.Parameters) (params ListAppsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "type",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Type = v.(OptListAppsType)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "filter",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Filter = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptListAppsSort)
+		}
+	}
+	return params
+}
+
+func decodeListAppsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListAppsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: type.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTypeVal ListAppsType
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
 						return err
 					}
-					params.Status = append(params.Status, paramsDotStatusVal)
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTypeVal = ListAppsType(c)
 					return nil
-				})
+				}(); err != nil {
+					return err
+				}
+				params.Type.SetTo(paramsDotTypeVal)
+				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				var failures []validate.FieldError
-				for i, elem := range params.Status {
+				if value, ok := params.Type.Get(); ok {
 					if err := func() error {
-						if err := elem.Validate(); err != nil {
+						if err := value.Validate(); err != nil {
 							return err
 						}
 						return nil
 					}(); err != nil {
-						failures = append(failures, validate.FieldError{
-							Name:  fmt.Sprintf("[%d]", i),
-							Error: err,
-						})
+						return err
 					}
 				}
-				if len(failures) > 0 {
-					return &validate.Error{Fields: failures}
-				}
 				return nil
 			}(); err != nil {
 				return err
@@ -945,63 +1205,95 @@ func decodeListTasksParams(args [0]string, argsEscaped bool, r *http.Request) (p
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "status",
+			Name: "type",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: priority.
+	// Decode query: filter.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "priority",
+			Name:    "filter",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotPriorityVal TaskPriority
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
+				var paramsDotFilterVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
 
-						paramsDotPriorityVal = TaskPriority(c)
-						return nil
-					}(); err != nil {
+					paramsDotFilterVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Filter.SetTo(paramsDotFilterVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "filter",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal ListAppsSort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
 						return err
 					}
-					params.Priority = append(params.Priority, paramsDotPriorityVal)
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = ListAppsSort(c)
 					return nil
-				})
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				var failures []validate.FieldError
-				for i, elem := range params.Priority {
+				if value, ok := params.Sort.Get(); ok {
 					if err := func() error {
-						if err := elem.Validate(); err != nil {
+						if err := value.Validate(); err != nil {
 							return err
 						}
 						return nil
 					}(); err != nil {
-						failures = append(failures, validate.FieldError{
-							Name:  fmt.Sprintf("[%d]", i),
-							Error: err,
-						})
+						return err
 					}
 				}
-				if len(failures) > 0 {
-					return &validate.Error{Fields: failures}
-				}
 				return nil
 			}(); err != nil {
 				return err
@@ -1010,22 +1302,46 @@ func decodeListTasksParams(args [0]string, argsEscaped bool, r *http.Request) (p
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "priority",
+			Name: "sort",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: filter.
+	return params, nil
+}
+
+// ListChatsParams is parameters of listChats operation.
+type ListChatsParams struct {
+	// Search by user name.
+	Search OptString `json:",omitempty,omitzero"`
+}
+
+func unpackListChatsParams(packed middleware.Parameters) (params ListChatsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Search = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeListChatsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListChatsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: search.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "filter",
+			Name:    "search",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotFilterVal string
+				var paramsDotSearchVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -1037,12 +1353,12 @@ func decodeListTasksParams(args [0]string, argsEscaped bool, r *http.Request) (p
 						return err
 					}
 
-					paramsDotFilterVal = c
+					paramsDotSearchVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Filter.SetTo(paramsDotFilterVal)
+				params.Search.SetTo(paramsDotSearchVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1051,7 +1367,7 @@ func decodeListTasksParams(args [0]string, argsEscaped bool, r *http.Request) (p
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "filter",
+			Name: "search",
 			In:   "query",
 			Err:  err,
 		}
@@ -1059,17 +1375,22 @@ func decodeListTasksParams(args [0]string, argsEscaped bool, r *http.Request) (p
 	return params, nil
 }
 
-// ListUsersParams is parameters of listUsers operation.
-type ListUsersParams struct {
-	Page     OptInt       `json:",omitempty,omitzero"`
-	PageSize OptInt       `json:",omitempty,omitzero"`
-	Status   []UserStatus `json:",omitempty"`
-	Role     []UserRole   `json:",omitempty"`
-	// Filter by username.
-	Username OptString `json:",omitempty,omitzero"`
+// ListLoginHistoryParams is parameters of listLoginHistory operation.
+type ListLoginHistoryParams struct {
+	UserId   string
+	Page     OptInt          `json:",omitempty,omitzero"`
+	PageSize OptInt          `json:",omitempty,omitzero"`
+	Outcome  OptLoginOutcome `json:",omitempty,omitzero"`
 }
 
-func unpackListUsersParams(packed middleware.Parameters) (params ListUsersParams) {
+func unpackListLoginHistoryParams(packed middleware.Parameters) (params ListLoginHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(string)
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "page",
@@ -1090,36 +1411,63 @@ func unpackListUsersParams(packed middleware.Parameters) (params ListUsersParams
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "status",
+			Name: "outcome",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Status = v.([]UserStatus)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "role",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Role = v.([]UserRole)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "username",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Username = v.(OptString)
+			params.Outcome = v.(OptLoginOutcome)
 		}
 	}
 	return params
 }
 
-func decodeListUsersParams(args [0]string, argsEscaped bool, r *http.Request) (params ListUsersParams, _ error) {
+func decodeListLoginHistoryParams(args [1]string, argsEscaped bool, r *http.Request) (params ListLoginHistoryParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
 	// Set default value for query: page.
 	{
 		val := int(1)
@@ -1157,6 +1505,31 @@ func decodeListUsersParams(args [0]string, argsEscaped bool, r *http.Request) (p
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
@@ -1203,6 +1576,31 @@ func decodeListUsersParams(args [0]string, argsEscaped bool, r *http.Request) (p
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.PageSize.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
@@ -1212,198 +1610,1865 @@ func decodeListUsersParams(args [0]string, argsEscaped bool, r *http.Request) (p
 			Err:  err,
 		}
 	}
-	// Decode query: status.
+	// Decode query: outcome.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "status",
+			Name:    "outcome",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotStatusVal UserStatus
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
+				var paramsDotOutcomeVal LoginOutcome
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-						c, err := conv.ToString(val)
-						if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOutcomeVal = LoginOutcome(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Outcome.SetTo(paramsDotOutcomeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Outcome.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
 							return err
 						}
-
-						paramsDotStatusVal = UserStatus(c)
 						return nil
 					}(); err != nil {
 						return err
 					}
-					params.Status = append(params.Status, paramsDotStatusVal)
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "outcome",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListTasksParams is parameters of listTasks operation.
+type ListTasksParams struct {
+	Page     OptInt         `json:",omitempty,omitzero"`
+	PageSize OptInt         `json:",omitempty,omitzero"`
+	Status   []TaskStatus   `json:",omitempty"`
+	Priority []TaskPriority `json:",omitempty"`
+	// Search filter for title or ID.
+	Filter OptString `json:",omitempty,omitzero"`
+	// Opaque cursor taken from cursors.next or cursors.prev. When set, the page after (or before) the
+	// cursor is returned and page is ignored.
+	Cursor OptString `json:",omitempty,omitzero"`
+}
+
+func unpackListTasksParams(packed middleware.Parameters) (params ListTasksParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "pageSize",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PageSize = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.([]TaskStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "priority",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Priority = v.([]TaskPriority)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "filter",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Filter = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeListTasksParams(args [0]string, argsEscaped bool, r *http.Request) (params ListTasksParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: page.
+	{
+		val := int(1)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
 					return nil
-				})
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				var failures []validate.FieldError
-				for i, elem := range params.Status {
+				if value, ok := params.Page.Get(); ok {
 					if err := func() error {
-						if err := elem.Validate(); err != nil {
-							return err
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
 						}
 						return nil
 					}(); err != nil {
-						failures = append(failures, validate.FieldError{
-							Name:  fmt.Sprintf("[%d]", i),
-							Error: err,
-						})
+						return err
 					}
 				}
-				if len(failures) > 0 {
-					return &validate.Error{Fields: failures}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: pageSize.
+	{
+		val := int(10)
+		params.PageSize.SetTo(val)
+	}
+	// Decode query: pageSize.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "pageSize",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageSizeVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageSizeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PageSize.SetTo(paramsDotPageSizeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.PageSize.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "pageSize",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotStatusVal TaskStatus
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotStatusVal = TaskStatus(c)
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Status = append(params.Status, paramsDotStatusVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.Status {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: priority.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "priority",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotPriorityVal TaskPriority
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotPriorityVal = TaskPriority(c)
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Priority = append(params.Priority, paramsDotPriorityVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.Priority {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "priority",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: filter.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "filter",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFilterVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFilterVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Filter.SetTo(paramsDotFilterVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "filter",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListUserSessionsParams is parameters of listUserSessions operation.
+type ListUserSessionsParams struct {
+	UserId string
+}
+
+func unpackListUserSessionsParams(packed middleware.Parameters) (params ListUserSessionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(string)
+	}
+	return params
+}
+
+func decodeListUserSessionsParams(args [1]string, argsEscaped bool, r *http.Request) (params ListUserSessionsParams, _ error) {
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListUsersParams is parameters of listUsers operation.
+type ListUsersParams struct {
+	Page     OptInt       `json:",omitempty,omitzero"`
+	PageSize OptInt       `json:",omitempty,omitzero"`
+	Status   []UserStatus `json:",omitempty"`
+	Role     []UserRole   `json:",omitempty"`
+	// Filter by username.
+	Username OptString `json:",omitempty,omitzero"`
+	// Free-text search across first name, last name, email, username and phone number. Exact email
+	// matches are listed first, then exact username or phone matches, then prefix matches, each group in
+	// the requested sort order.
+	Q OptString `json:",omitempty,omitzero"`
+	// Comma-separated sort fields, each optionally prefixed with "-" for descending order, e.g.
+	// "lastName,-createdAt". Supported fields are firstName, lastName, username, email, phoneNumber,
+	// status, role, createdAt and updatedAt. Defaults to "-createdAt".
+	Sort []string `json:",omitempty"`
+	// Opaque cursor taken from cursors.next or cursors.prev. When set, the page after (or before) the
+	// cursor is returned and page is ignored.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// Also return deleted users. Only admins may set this.
+	IncludeDeleted OptBool `json:",omitempty,omitzero"`
+}
+
+func unpackListUsersParams(packed middleware.Parameters) (params ListUsersParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "pageSize",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PageSize = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.([]UserStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "role",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Role = v.([]UserRole)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "username",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Username = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Q = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.([]string)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "includeDeleted",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IncludeDeleted = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeListUsersParams(args [0]string, argsEscaped bool, r *http.Request) (params ListUsersParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: page.
+	{
+		val := int(1)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: pageSize.
+	{
+		val := int(10)
+		params.PageSize.SetTo(val)
+	}
+	// Decode query: pageSize.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "pageSize",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageSizeVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageSizeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PageSize.SetTo(paramsDotPageSizeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.PageSize.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "pageSize",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotStatusVal UserStatus
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotStatusVal = UserStatus(c)
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Status = append(params.Status, paramsDotStatusVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.Status {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: role.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "role",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotRoleVal UserRole
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotRoleVal = UserRole(c)
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Role = append(params.Role, paramsDotRoleVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.Role {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "role",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: username.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "username",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUsernameVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUsernameVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Username.SetTo(paramsDotUsernameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "username",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotQVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotQVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Q.SetTo(paramsDotQVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotSortVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotSortVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Sort = append(params.Sort, paramsDotSortVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: includeDeleted.
+	{
+		val := bool(false)
+		params.IncludeDeleted.SetTo(val)
+	}
+	// Decode query: includeDeleted.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "includeDeleted",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIncludeDeletedVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIncludeDeletedVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IncludeDeleted.SetTo(paramsDotIncludeDeletedVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "includeDeleted",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PurgeUserParams is parameters of purgeUser operation.
+type PurgeUserParams struct {
+	UserId string
+}
+
+func unpackPurgeUserParams(packed middleware.Parameters) (params PurgeUserParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(string)
+	}
+	return params
+}
+
+func decodePurgeUserParams(args [1]string, argsEscaped bool, r *http.Request) (params PurgeUserParams, _ error) {
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ResendInvitationParams is parameters of resendInvitation operation.
+type ResendInvitationParams struct {
+	UserId string
+}
+
+func unpackResendInvitationParams(packed middleware.Parameters) (params ResendInvitationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(string)
+	}
+	return params
+}
+
+func decodeResendInvitationParams(args [1]string, argsEscaped bool, r *http.Request) (params ResendInvitationParams, _ error) {
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ResetUserMfaParams is parameters of resetUserMfa operation.
+type ResetUserMfaParams struct {
+	UserId string
+}
+
+func unpackResetUserMfaParams(packed middleware.Parameters) (params ResetUserMfaParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(string)
+	}
+	return params
+}
+
+func decodeResetUserMfaParams(args [1]string, argsEscaped bool, r *http.Request) (params ResetUserMfaParams, _ error) {
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RestoreUserParams is parameters of restoreUser operation.
+type RestoreUserParams struct {
+	UserId string
+	// ETag of the version the change is based on. The request fails with 412 when the record has changed
+	// since; without it the change is applied unconditionally.
+	IfMatch OptString `json:",omitempty,omitzero"`
+}
+
+func unpackRestoreUserParams(packed middleware.Parameters) (params RestoreUserParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeRestoreUserParams(args [1]string, argsEscaped bool, r *http.Request) (params RestoreUserParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RevokeApiKeyParams is parameters of revokeApiKey operation.
+type RevokeApiKeyParams struct {
+	KeyId string
+}
+
+func unpackRevokeApiKeyParams(packed middleware.Parameters) (params RevokeApiKeyParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "keyId",
+			In:   "path",
+		}
+		params.KeyId = packed[key].(string)
+	}
+	return params
+}
+
+func decodeRevokeApiKeyParams(args [1]string, argsEscaped bool, r *http.Request) (params RevokeApiKeyParams, _ error) {
+	// Decode path: keyId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "keyId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.KeyId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "keyId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RevokeInvitationParams is parameters of revokeInvitation operation.
+type RevokeInvitationParams struct {
+	UserId string
+}
+
+func unpackRevokeInvitationParams(packed middleware.Parameters) (params RevokeInvitationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(string)
+	}
+	return params
+}
+
+func decodeRevokeInvitationParams(args [1]string, argsEscaped bool, r *http.Request) (params RevokeInvitationParams, _ error) {
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RevokeUserSessionParams is parameters of revokeUserSession operation.
+type RevokeUserSessionParams struct {
+	UserId    string
+	SessionId string
+}
+
+func unpackRevokeUserSessionParams(packed middleware.Parameters) (params RevokeUserSessionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sessionId",
+			In:   "path",
+		}
+		params.SessionId = packed[key].(string)
+	}
+	return params
+}
+
+func decodeRevokeUserSessionParams(args [2]string, argsEscaped bool, r *http.Request) (params RevokeUserSessionParams, _ error) {
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: sessionId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "sessionId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.SessionId = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "status",
-			In:   "query",
+			Name: "sessionId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: role.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "role",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotRoleVal UserRole
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
+// RevokeUserSessionsParams is parameters of revokeUserSessions operation.
+type RevokeUserSessionsParams struct {
+	UserId string
+}
 
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
+func unpackRevokeUserSessionsParams(packed middleware.Parameters) (params RevokeUserSessionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(string)
+	}
+	return params
+}
 
-						paramsDotRoleVal = UserRole(c)
-						return nil
-					}(); err != nil {
-						return err
-					}
-					params.Role = append(params.Role, paramsDotRoleVal)
-					return nil
-				})
-			}); err != nil {
-				return err
+func decodeRevokeUserSessionsParams(args [1]string, argsEscaped bool, r *http.Request) (params RevokeUserSessionsParams, _ error) {
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
 			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				var failures []validate.FieldError
-				for i, elem := range params.Role {
-					if err := func() error {
-						if err := elem.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						failures = append(failures, validate.FieldError{
-							Name:  fmt.Sprintf("[%d]", i),
-							Error: err,
-						})
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
 				}
-				if len(failures) > 0 {
-					return &validate.Error{Fields: failures}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.UserId = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "role",
-			In:   "query",
+			Name: "userId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: username.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "username",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// SendMessageParams is parameters of sendMessage operation.
+type SendMessageParams struct {
+	ChatId string
+}
+
+func unpackSendMessageParams(packed middleware.Parameters) (params SendMessageParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "chatId",
+			In:   "path",
 		}
+		params.ChatId = packed[key].(string)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUsernameVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+func decodeSendMessageParams(args [1]string, argsEscaped bool, r *http.Request) (params SendMessageParams, _ error) {
+	// Decode path: chatId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "chatId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotUsernameVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Username.SetTo(paramsDotUsernameVal)
+
+				params.ChatId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "username",
-			In:   "query",
+			Name: "chatId",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// SendMessageParams is parameters of sendMessage operation.
-type SendMessageParams struct {
-	ChatId string
+// UnlockUserParams is parameters of unlockUser operation.
+type UnlockUserParams struct {
+	UserId string
 }
 
-func unpackSendMessageParams(packed middleware.Parameters) (params SendMessageParams) {
+func unpackUnlockUserParams(packed middleware.Parameters) (params UnlockUserParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "chatId",
+			Name: "userId",
 			In:   "path",
 		}
-		params.ChatId = packed[key].(string)
+		params.UserId = packed[key].(string)
 	}
 	return params
 }
 
-func decodeSendMessageParams(args [1]string, argsEscaped bool, r *http.Request) (params SendMessageParams, _ error) {
-	// Decode path: chatId.
+func decodeUnlockUserParams(args [1]string, argsEscaped bool, r *http.Request) (params UnlockUserParams, _ error) {
+	// Decode path: userId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1415,7 +3480,7 @@ func decodeSendMessageParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "chatId",
+				Param:   "userId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1432,7 +3497,7 @@ func decodeSendMessageParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.ChatId = c
+				params.UserId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1443,7 +3508,7 @@ func decodeSendMessageParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "chatId",
+			Name: "userId",
 			In:   "path",
 			Err:  err,
 		}
@@ -1454,6 +3519,9 @@ func decodeSendMessageParams(args [1]string, argsEscaped bool, r *http.Request)
 // UpdateTaskParams is parameters of updateTask operation.
 type UpdateTaskParams struct {
 	TaskId string
+	// ETag of the version the change is based on. The request fails with 412 when the record has changed
+	// since; without it the change is applied unconditionally.
+	IfMatch OptString `json:",omitempty,omitzero"`
 }
 
 func unpackUpdateTaskParams(packed middleware.Parameters) (params UpdateTaskParams) {
@@ -1464,10 +3532,20 @@ func unpackUpdateTaskParams(packed middleware.Parameters) (params UpdateTaskPara
 		}
 		params.TaskId = packed[key].(string)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
+	}
 	return params
 }
 
 func decodeUpdateTaskParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateTaskParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
 	// Decode path: taskId.
 	if err := func() error {
 		param := args[0]
@@ -1513,12 +3591,54 @@ func decodeUpdateTaskParams(args [1]string, argsEscaped bool, r *http.Request) (
 			Err:  err,
 		}
 	}
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
 // UpdateUserParams is parameters of updateUser operation.
 type UpdateUserParams struct {
 	UserId string
+	// ETag of the version the change is based on. The request fails with 412 when the record has changed
+	// since; without it the change is applied unconditionally.
+	IfMatch OptString `json:",omitempty,omitzero"`
 }
 
 func unpackUpdateUserParams(packed middleware.Parameters) (params UpdateUserParams) {
@@ -1529,10 +3649,20 @@ func unpackUpdateUserParams(packed middleware.Parameters) (params UpdateUserPara
 		}
 		params.UserId = packed[key].(string)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
+	}
 	return params
 }
 
 func decodeUpdateUserParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateUserParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
 	// Decode path: userId.
 	if err := func() error {
 		param := args[0]
@@ -1578,5 +3708,44 @@ func decodeUpdateUserParams(args [1]string, argsEscaped bool, r *http.Request) (
 			Err:  err,
 		}
 	}
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
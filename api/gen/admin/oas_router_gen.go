@@ -40,7 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
-	args := [1]string{}
+	args := [2]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -187,6 +187,148 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
+					case 'a': // Prefix: "api-keys"
+
+						if l := len("api-keys"); len(elem) >= l && elem[0:l] == "api-keys" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleListApiKeysRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleCreateApiKeyRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "keyId"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleRevokeApiKeyRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE")
+								}
+
+								return
+							}
+
+						}
+
+					case 'i': // Prefix: "i"
+
+						if l := len("i"); len(elem) >= l && elem[0:l] == "i" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'm': // Prefix: "mpersonation/stop"
+
+							if l := len("mpersonation/stop"); len(elem) >= l && elem[0:l] == "mpersonation/stop" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleStopImpersonationRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 'n': // Prefix: "nvitations/"
+
+							if l := len("nvitations/"); len(elem) >= l && elem[0:l] == "nvitations/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "token"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleGetInvitationRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/accept"
+
+								if l := len("/accept"); len(elem) >= l && elem[0:l] == "/accept" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleAcceptInvitationRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
 					case 'l': // Prefix: "log"
 
 						if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
@@ -241,108 +383,344 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						}
 
-					case 'm': // Prefix: "me"
+					case 'm': // Prefix: "m"
 
-						if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+						if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleGetCurrentUserRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
+							break
+						}
+						switch elem[0] {
+						case 'e': // Prefix: "e"
+
+							if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+								elem = elem[l:]
+							} else {
+								break
 							}
 
-							return
-						}
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetCurrentUserRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
 
-					}
+								return
+							}
 
-				}
+						case 'f': // Prefix: "fa/"
 
-			case 'c': // Prefix: "chats"
+							if l := len("fa/"); len(elem) >= l && elem[0:l] == "fa/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-				if l := len("chats"); len(elem) >= l && elem[0:l] == "chats" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "confirm"
 
-				if len(elem) == 0 {
-					switch r.Method {
-					case "GET":
-						s.handleListChatsRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET")
-					}
+								if l := len("confirm"); len(elem) >= l && elem[0:l] == "confirm" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-					return
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleConfirmMfaRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+									return
+								}
 
-					// Param: "chatId"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
+							case 'e': // Prefix: "enroll"
+
+								if l := len("enroll"); len(elem) >= l && elem[0:l] == "enroll" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleEnrollMfaRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'v': // Prefix: "verify"
+
+								if l := len("verify"); len(elem) >= l && elem[0:l] == "verify" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleVerifyMfaRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
 
-					if len(elem) == 0 {
-						switch r.Method {
-						case "GET":
-							s.handleGetChatRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
 						}
 
-						return
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/messages"
+					case 'p': // Prefix: "password/"
 
-						if l := len("/messages"); len(elem) >= l && elem[0:l] == "/messages" {
+						if l := len("password/"); len(elem) >= l && elem[0:l] == "password/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "POST":
-								s.handleSendMessageRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "POST")
-							}
-
-							return
+							break
 						}
+						switch elem[0] {
+						case 'c': // Prefix: "change"
 
-					}
+							if l := len("change"); len(elem) >= l && elem[0:l] == "change" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-				}
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleChangePasswordRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
 
-			case 'd': // Prefix: "dashboard/"
+								return
+							}
 
-				if l := len("dashboard/"); len(elem) >= l && elem[0:l] == "dashboard/" {
+						case 'f': // Prefix: "forgot"
+
+							if l := len("forgot"); len(elem) >= l && elem[0:l] == "forgot" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleForgotPasswordRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 'r': // Prefix: "reset"
+
+							if l := len("reset"); len(elem) >= l && elem[0:l] == "reset" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleResetPasswordRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					case 'r': // Prefix: "refresh"
+
+						if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleRefreshTokenRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					case 's': // Prefix: "sso/"
+
+						if l := len("sso/"); len(elem) >= l && elem[0:l] == "sso/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "callback"
+
+							if l := len("callback"); len(elem) >= l && elem[0:l] == "callback" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleCompleteSsoRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 's': // Prefix: "start"
+
+							if l := len("start"); len(elem) >= l && elem[0:l] == "start" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleStartSsoRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'c': // Prefix: "chats"
+
+				if l := len("chats"); len(elem) >= l && elem[0:l] == "chats" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleListChatsRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "chatId"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetChatRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/messages"
+
+						if l := len("/messages"); len(elem) >= l && elem[0:l] == "/messages" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleSendMessageRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					}
+
+				}
+
+			case 'd': // Prefix: "dashboard/"
+
+				if l := len("dashboard/"); len(elem) >= l && elem[0:l] == "dashboard/" {
 					elem = elem[l:]
 				} else {
 					break
@@ -509,9 +887,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
-					case 'i': // Prefix: "invite"
+					case 'b': // Prefix: "bulk"
 						origElem := elem
-						if l := len("invite"); len(elem) >= l && elem[0:l] == "invite" {
+						if l := len("bulk"); len(elem) >= l && elem[0:l] == "bulk" {
 							elem = elem[l:]
 						} else {
 							break
@@ -521,7 +899,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							// Leaf node.
 							switch r.Method {
 							case "POST":
-								s.handleInviteUserRequest([0]string{}, elemIsEscaped, w, r)
+								s.handleBulkUserActionRequest([0]string{}, elemIsEscaped, w, r)
 							default:
 								s.notAllowed(w, r, "POST")
 							}
@@ -530,174 +908,111 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						}
 
 						elem = origElem
-					}
-					// Param: "userId"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
-					}
-					args[0] = elem
-					elem = ""
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "DELETE":
-							s.handleDeleteUserRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "GET":
-							s.handleGetUserRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "PUT":
-							s.handleUpdateUserRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "DELETE,GET,PUT")
+					case 'e': // Prefix: "export"
+						origElem := elem
+						if l := len("export"); len(elem) >= l && elem[0:l] == "export" {
+							elem = elem[l:]
+						} else {
+							break
 						}
 
-						return
-					}
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleExportUsersRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
 
-				}
+							return
+						}
 
-			}
+						elem = origElem
+					case 'i': // Prefix: "i"
+						origElem := elem
+						if l := len("i"); len(elem) >= l && elem[0:l] == "i" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-		}
-	}
-	s.notFound(w, r)
-}
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'm': // Prefix: "mport"
 
-// Route is route object.
-type Route struct {
-	name           string
-	summary        string
-	operationID    string
-	operationGroup string
-	pathPattern    string
-	count          int
-	args           [1]string
-}
+							if l := len("mport"); len(elem) >= l && elem[0:l] == "mport" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-// Name returns ogen operation name.
-//
-// It is guaranteed to be unique and not empty.
-func (r Route) Name() string {
-	return r.name
-}
-
-// Summary returns OpenAPI summary.
-func (r Route) Summary() string {
-	return r.summary
-}
-
-// OperationID returns OpenAPI operationId.
-func (r Route) OperationID() string {
-	return r.operationID
-}
-
-// OperationGroup returns the x-ogen-operation-group value.
-func (r Route) OperationGroup() string {
-	return r.operationGroup
-}
-
-// PathPattern returns OpenAPI path.
-func (r Route) PathPattern() string {
-	return r.pathPattern
-}
-
-// Args returns parsed arguments.
-func (r Route) Args() []string {
-	return r.args[:r.count]
-}
-
-// FindRoute finds Route for given method and path.
-//
-// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
-func (s *Server) FindRoute(method, path string) (Route, bool) {
-	return s.FindPath(method, &url.URL{Path: path})
-}
-
-// FindPath finds Route for given method and URL.
-func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
-	var (
-		elem = u.Path
-		args = r.args
-	)
-	if rawPath := u.RawPath; rawPath != "" {
-		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
-			elem = normalized
-		}
-		defer func() {
-			for i, arg := range r.args[:r.count] {
-				if unescaped, err := url.PathUnescape(arg); err == nil {
-					r.args[i] = unescaped
-				}
-			}
-		}()
-	}
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleImportUsersRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
 
-	elem, ok := s.cutPrefix(elem)
-	if !ok {
-		return r, false
-	}
+								return
+							}
 
-	// Static code generated router with unwrapped path search.
-	switch {
-	default:
-		if len(elem) == 0 {
-			break
-		}
-		switch elem[0] {
-		case '/': // Prefix: "/"
+						case 'n': // Prefix: "nvite"
 
-			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
+							if l := len("nvite"); len(elem) >= l && elem[0:l] == "nvite" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
-			case 'a': // Prefix: "a"
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleInviteUserRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
 
-				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+								return
+							}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'p': // Prefix: "pps"
+						}
 
-					if l := len("pps"); len(elem) >= l && elem[0:l] == "pps" {
-						elem = elem[l:]
-					} else {
-						break
+						elem = origElem
+					}
+					// Param: "userId"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
 					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
 					if len(elem) == 0 {
-						switch method {
+						switch r.Method {
+						case "DELETE":
+							s.handleDeleteUserRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
 						case "GET":
-							r.name = ListAppsOperation
-							r.summary = "List all app integrations"
-							r.operationID = "listApps"
-							r.operationGroup = ""
-							r.pathPattern = "/apps"
-							r.args = args
-							r.count = 0
-							return r, true
+							s.handleGetUserRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handleUpdateUserRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
 						default:
-							return
+							s.notAllowed(w, r, "DELETE,GET,PUT")
 						}
+
+						return
 					}
 					switch elem[0] {
 					case '/': // Prefix: "/"
@@ -708,22 +1023,13 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 
-						// Param: "appId"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
-
 						if len(elem) == 0 {
 							break
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/"
+						case 'i': // Prefix: "i"
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							if l := len("i"); len(elem) >= l && elem[0:l] == "i" {
 								elem = elem[l:]
 							} else {
 								break
@@ -733,9 +1039,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 							switch elem[0] {
-							case 'c': // Prefix: "connect"
+							case 'm': // Prefix: "mpersonate"
 
-								if l := len("connect"); len(elem) >= l && elem[0:l] == "connect" {
+								if l := len("mpersonate"); len(elem) >= l && elem[0:l] == "mpersonate" {
 									elem = elem[l:]
 								} else {
 									break
@@ -743,79 +1049,68 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 								if len(elem) == 0 {
 									// Leaf node.
-									switch method {
+									switch r.Method {
 									case "POST":
-										r.name = ConnectAppOperation
-										r.summary = "Connect an app integration"
-										r.operationID = "connectApp"
-										r.operationGroup = ""
-										r.pathPattern = "/apps/{appId}/connect"
-										r.args = args
-										r.count = 1
-										return r, true
+										s.handleImpersonateUserRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
 									default:
-										return
+										s.notAllowed(w, r, "POST")
 									}
+
+									return
 								}
 
-							case 'd': // Prefix: "disconnect"
+							case 'n': // Prefix: "nvitation"
 
-								if l := len("disconnect"); len(elem) >= l && elem[0:l] == "disconnect" {
+								if l := len("nvitation"); len(elem) >= l && elem[0:l] == "nvitation" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "POST":
-										r.name = DisconnectAppOperation
-										r.summary = "Disconnect an app integration"
-										r.operationID = "disconnectApp"
-										r.operationGroup = ""
-										r.pathPattern = "/apps/{appId}/disconnect"
-										r.args = args
-										r.count = 1
-										return r, true
+									switch r.Method {
+									case "DELETE":
+										s.handleRevokeInvitationRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
 									default:
-										return
+										s.notAllowed(w, r, "DELETE")
 									}
-								}
-
-							}
 
-						}
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/resend"
 
-					}
+									if l := len("/resend"); len(elem) >= l && elem[0:l] == "/resend" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-				case 'u': // Prefix: "uth/"
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleResendInvitationRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
 
-					if l := len("uth/"); len(elem) >= l && elem[0:l] == "uth/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+										return
+									}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'l': // Prefix: "log"
+								}
 
-						if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+							}
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'i': // Prefix: "in"
+						case 'l': // Prefix: "login-history"
 
-							if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+							if l := len("login-history"); len(elem) >= l && elem[0:l] == "login-history" {
 								elem = elem[l:]
 							} else {
 								break
@@ -823,24 +1118,21 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 							if len(elem) == 0 {
 								// Leaf node.
-								switch method {
-								case "POST":
-									r.name = LoginOperation
-									r.summary = "Authenticate user"
-									r.operationID = "login"
-									r.operationGroup = ""
-									r.pathPattern = "/auth/login"
-									r.args = args
-									r.count = 0
-									return r, true
+								switch r.Method {
+								case "GET":
+									s.handleListLoginHistoryRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
 								default:
-									return
+									s.notAllowed(w, r, "GET")
 								}
+
+								return
 							}
 
-						case 'o': // Prefix: "out"
+						case 'm': // Prefix: "mfa"
 
-							if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+							if l := len("mfa"); len(elem) >= l && elem[0:l] == "mfa" {
 								elem = elem[l:]
 							} else {
 								break
@@ -848,26 +1140,838 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 							if len(elem) == 0 {
 								// Leaf node.
-								switch method {
-								case "POST":
-									r.name = LogoutOperation
-									r.summary = "Logout user"
-									r.operationID = "logout"
-									r.operationGroup = ""
-									r.pathPattern = "/auth/logout"
-									r.args = args
-									r.count = 0
-									return r, true
+								switch r.Method {
+								case "DELETE":
+									s.handleResetUserMfaRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
 								default:
-									return
+									s.notAllowed(w, r, "DELETE")
+								}
+
+								return
+							}
+
+						case 'p': // Prefix: "purge"
+
+							if l := len("purge"); len(elem) >= l && elem[0:l] == "purge" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handlePurgeUserRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE")
+								}
+
+								return
+							}
+
+						case 'r': // Prefix: "restore"
+
+							if l := len("restore"); len(elem) >= l && elem[0:l] == "restore" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleRestoreUserRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 's': // Prefix: "sessions"
+
+							if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "DELETE":
+									s.handleRevokeUserSessionsRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleListUserSessionsRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "sessionId"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[1] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleRevokeUserSessionRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE")
+									}
+
+									return
+								}
+
+							}
+
+						case 'u': // Prefix: "unlock"
+
+							if l := len("unlock"); len(elem) >= l && elem[0:l] == "unlock" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleUnlockUserRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				}
+
+			}
+
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
+	name           string
+	summary        string
+	operationID    string
+	operationGroup string
+	pathPattern    string
+	count          int
+	args           [2]string
+}
+
+// Name returns ogen operation name.
+//
+// It is guaranteed to be unique and not empty.
+func (r Route) Name() string {
+	return r.name
+}
+
+// Summary returns OpenAPI summary.
+func (r Route) Summary() string {
+	return r.summary
+}
+
+// OperationID returns OpenAPI operationId.
+func (r Route) OperationID() string {
+	return r.operationID
+}
+
+// OperationGroup returns the x-ogen-operation-group value.
+func (r Route) OperationGroup() string {
+	return r.operationGroup
+}
+
+// PathPattern returns OpenAPI path.
+func (r Route) PathPattern() string {
+	return r.pathPattern
+}
+
+// Args returns parsed arguments.
+func (r Route) Args() []string {
+	return r.args[:r.count]
+}
+
+// FindRoute finds Route for given method and path.
+//
+// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
+func (s *Server) FindRoute(method, path string) (Route, bool) {
+	return s.FindPath(method, &url.URL{Path: path})
+}
+
+// FindPath finds Route for given method and URL.
+func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
+	var (
+		elem = u.Path
+		args = r.args
+	)
+	if rawPath := u.RawPath; rawPath != "" {
+		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
+			elem = normalized
+		}
+		defer func() {
+			for i, arg := range r.args[:r.count] {
+				if unescaped, err := url.PathUnescape(arg); err == nil {
+					r.args[i] = unescaped
+				}
+			}
+		}()
+	}
+
+	elem, ok := s.cutPrefix(elem)
+	if !ok {
+		return r, false
+	}
+
+	// Static code generated router with unwrapped path search.
+	switch {
+	default:
+		if len(elem) == 0 {
+			break
+		}
+		switch elem[0] {
+		case '/': // Prefix: "/"
+
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
+
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "a"
+
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'p': // Prefix: "pps"
+
+					if l := len("pps"); len(elem) >= l && elem[0:l] == "pps" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = ListAppsOperation
+							r.summary = "List all app integrations"
+							r.operationID = "listApps"
+							r.operationGroup = ""
+							r.pathPattern = "/apps"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "appId"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "connect"
+
+								if l := len("connect"); len(elem) >= l && elem[0:l] == "connect" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = ConnectAppOperation
+										r.summary = "Connect an app integration"
+										r.operationID = "connectApp"
+										r.operationGroup = ""
+										r.pathPattern = "/apps/{appId}/connect"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'd': // Prefix: "disconnect"
+
+								if l := len("disconnect"); len(elem) >= l && elem[0:l] == "disconnect" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = DisconnectAppOperation
+										r.summary = "Disconnect an app integration"
+										r.operationID = "disconnectApp"
+										r.operationGroup = ""
+										r.pathPattern = "/apps/{appId}/disconnect"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'u': // Prefix: "uth/"
+
+					if l := len("uth/"); len(elem) >= l && elem[0:l] == "uth/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "api-keys"
+
+						if l := len("api-keys"); len(elem) >= l && elem[0:l] == "api-keys" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ListApiKeysOperation
+								r.summary = "List the current user's API keys"
+								r.operationID = "listApiKeys"
+								r.operationGroup = ""
+								r.pathPattern = "/auth/api-keys"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = CreateApiKeyOperation
+								r.summary = "Create an API key for the current user"
+								r.operationID = "createApiKey"
+								r.operationGroup = ""
+								r.pathPattern = "/auth/api-keys"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "keyId"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = RevokeApiKeyOperation
+									r.summary = "Revoke one of the current user's API keys"
+									r.operationID = "revokeApiKey"
+									r.operationGroup = ""
+									r.pathPattern = "/auth/api-keys/{keyId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'i': // Prefix: "i"
+
+						if l := len("i"); len(elem) >= l && elem[0:l] == "i" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'm': // Prefix: "mpersonation/stop"
+
+							if l := len("mpersonation/stop"); len(elem) >= l && elem[0:l] == "mpersonation/stop" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = StopImpersonationOperation
+									r.summary = "End the current impersonation and revoke its token"
+									r.operationID = "stopImpersonation"
+									r.operationGroup = ""
+									r.pathPattern = "/auth/impersonation/stop"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'n': // Prefix: "nvitations/"
+
+							if l := len("nvitations/"); len(elem) >= l && elem[0:l] == "nvitations/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "token"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = GetInvitationOperation
+									r.summary = "Look up a pending invitation"
+									r.operationID = "getInvitation"
+									r.operationGroup = ""
+									r.pathPattern = "/auth/invitations/{token}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/accept"
+
+								if l := len("/accept"); len(elem) >= l && elem[0:l] == "/accept" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = AcceptInvitationOperation
+										r.summary = "Accept an invitation and activate the account"
+										r.operationID = "acceptInvitation"
+										r.operationGroup = ""
+										r.pathPattern = "/auth/invitations/{token}/accept"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					case 'l': // Prefix: "log"
+
+						if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'i': // Prefix: "in"
+
+							if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = LoginOperation
+									r.summary = "Authenticate user"
+									r.operationID = "login"
+									r.operationGroup = ""
+									r.pathPattern = "/auth/login"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'o': // Prefix: "out"
+
+							if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = LogoutOperation
+									r.summary = "Logout user"
+									r.operationID = "logout"
+									r.operationGroup = ""
+									r.pathPattern = "/auth/logout"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'm': // Prefix: "m"
+
+						if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'e': // Prefix: "e"
+
+							if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetCurrentUserOperation
+									r.summary = "Get current authenticated user"
+									r.operationID = "getCurrentUser"
+									r.operationGroup = ""
+									r.pathPattern = "/auth/me"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'f': // Prefix: "fa/"
+
+							if l := len("fa/"); len(elem) >= l && elem[0:l] == "fa/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "confirm"
+
+								if l := len("confirm"); len(elem) >= l && elem[0:l] == "confirm" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = ConfirmMfaOperation
+										r.summary = "Enable MFA by confirming the first code from the authenticator"
+										r.operationID = "confirmMfa"
+										r.operationGroup = ""
+										r.pathPattern = "/auth/mfa/confirm"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'e': // Prefix: "enroll"
+
+								if l := len("enroll"); len(elem) >= l && elem[0:l] == "enroll" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = EnrollMfaOperation
+										r.summary = "Start TOTP enrolment for the current user"
+										r.operationID = "enrollMfa"
+										r.operationGroup = ""
+										r.pathPattern = "/auth/mfa/enroll"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'v': // Prefix: "verify"
+
+								if l := len("verify"); len(elem) >= l && elem[0:l] == "verify" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = VerifyMfaOperation
+										r.summary = "Complete a login with a TOTP or recovery code"
+										r.operationID = "verifyMfa"
+										r.operationGroup = ""
+										r.pathPattern = "/auth/mfa/verify"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					case 'p': // Prefix: "password/"
+
+						if l := len("password/"); len(elem) >= l && elem[0:l] == "password/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "change"
+
+							if l := len("change"); len(elem) >= l && elem[0:l] == "change" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = ChangePasswordOperation
+									r.summary = "Change the current user's password"
+									r.operationID = "changePassword"
+									r.operationGroup = ""
+									r.pathPattern = "/auth/password/change"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'f': // Prefix: "forgot"
+
+							if l := len("forgot"); len(elem) >= l && elem[0:l] == "forgot" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = ForgotPasswordOperation
+									r.summary = "Email a password reset link"
+									r.operationID = "forgotPassword"
+									r.operationGroup = ""
+									r.pathPattern = "/auth/password/forgot"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'r': // Prefix: "reset"
+
+							if l := len("reset"); len(elem) >= l && elem[0:l] == "reset" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = ResetPasswordOperation
+									r.summary = "Set a new password using a reset token"
+									r.operationID = "resetPassword"
+									r.operationGroup = ""
+									r.pathPattern = "/auth/password/reset"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
 								}
 							}
 
 						}
 
-					case 'm': // Prefix: "me"
+					case 'r': // Prefix: "refresh"
 
-						if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+						if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
 							elem = elem[l:]
 						} else {
 							break
@@ -876,12 +1980,12 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							// Leaf node.
 							switch method {
-							case "GET":
-								r.name = GetCurrentUserOperation
-								r.summary = "Get current authenticated user"
-								r.operationID = "getCurrentUser"
+							case "POST":
+								r.name = RefreshTokenOperation
+								r.summary = "Exchange a refresh token for a new token pair"
+								r.operationID = "refreshToken"
 								r.operationGroup = ""
-								r.pathPattern = "/auth/me"
+								r.pathPattern = "/auth/refresh"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -890,6 +1994,70 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 						}
 
+					case 's': // Prefix: "sso/"
+
+						if l := len("sso/"); len(elem) >= l && elem[0:l] == "sso/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "callback"
+
+							if l := len("callback"); len(elem) >= l && elem[0:l] == "callback" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = CompleteSsoOperation
+									r.summary = "Complete single sign-on with the code returned by the identity provider"
+									r.operationID = "completeSso"
+									r.operationGroup = ""
+									r.pathPattern = "/auth/sso/callback"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 's': // Prefix: "start"
+
+							if l := len("start"); len(elem) >= l && elem[0:l] == "start" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = StartSsoOperation
+									r.summary = "Begin single sign-on with the company identity provider"
+									r.operationID = "startSso"
+									r.operationGroup = ""
+									r.pathPattern = "/auth/sso/start"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
 					}
 
 				}
@@ -1201,44 +2369,134 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 					switch elem[0] {
-					case 'i': // Prefix: "invite"
+					case 'b': // Prefix: "bulk"
+						origElem := elem
+						if l := len("bulk"); len(elem) >= l && elem[0:l] == "bulk" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = BulkUserActionOperation
+								r.summary = "Change the status or role of, or delete, several users at once"
+								r.operationID = "bulkUserAction"
+								r.operationGroup = ""
+								r.pathPattern = "/users/bulk"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					case 'e': // Prefix: "export"
+						origElem := elem
+						if l := len("export"); len(elem) >= l && elem[0:l] == "export" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = ExportUsersOperation
+								r.summary = "Export users as CSV or XLSX"
+								r.operationID = "exportUsers"
+								r.operationGroup = ""
+								r.pathPattern = "/users/export"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					case 'i': // Prefix: "i"
 						origElem := elem
-						if l := len("invite"); len(elem) >= l && elem[0:l] == "invite" {
+						if l := len("i"); len(elem) >= l && elem[0:l] == "i" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "POST":
-								r.name = InviteUserOperation
-								r.summary = "Invite a new user"
-								r.operationID = "inviteUser"
-								r.operationGroup = ""
-								r.pathPattern = "/users/invite"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'm': // Prefix: "mport"
+
+							if l := len("mport"); len(elem) >= l && elem[0:l] == "mport" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = ImportUsersOperation
+									r.summary = "Create users in bulk from a CSV file"
+									r.operationID = "importUsers"
+									r.operationGroup = ""
+									r.pathPattern = "/users/import"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'n': // Prefix: "nvite"
+
+							if l := len("nvite"); len(elem) >= l && elem[0:l] == "nvite" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = InviteUserOperation
+									r.summary = "Invite a new user"
+									r.operationID = "inviteUser"
+									r.operationGroup = ""
+									r.pathPattern = "/users/invite"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
 							}
+
 						}
 
 						elem = origElem
 					}
 					// Param: "userId"
-					// Leaf parameter, slashes are prohibited
+					// Match until "/"
 					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
+					if idx < 0 {
+						idx = len(elem)
 					}
-					args[0] = elem
-					elem = ""
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch method {
 						case "DELETE":
 							r.name = DeleteUserOperation
@@ -1271,6 +2529,306 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'i': // Prefix: "i"
+
+							if l := len("i"); len(elem) >= l && elem[0:l] == "i" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'm': // Prefix: "mpersonate"
+
+								if l := len("mpersonate"); len(elem) >= l && elem[0:l] == "mpersonate" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = ImpersonateUserOperation
+										r.summary = "Obtain a short-lived token acting as the user"
+										r.operationID = "impersonateUser"
+										r.operationGroup = ""
+										r.pathPattern = "/users/{userId}/impersonate"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'n': // Prefix: "nvitation"
+
+								if l := len("nvitation"); len(elem) >= l && elem[0:l] == "nvitation" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "DELETE":
+										r.name = RevokeInvitationOperation
+										r.summary = "Revoke a pending invitation and remove the invited user"
+										r.operationID = "revokeInvitation"
+										r.operationGroup = ""
+										r.pathPattern = "/users/{userId}/invitation"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/resend"
+
+									if l := len("/resend"); len(elem) >= l && elem[0:l] == "/resend" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = ResendInvitationOperation
+											r.summary = "Send a new invitation link, invalidating previous ones"
+											r.operationID = "resendInvitation"
+											r.operationGroup = ""
+											r.pathPattern = "/users/{userId}/invitation/resend"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						case 'l': // Prefix: "login-history"
+
+							if l := len("login-history"); len(elem) >= l && elem[0:l] == "login-history" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = ListLoginHistoryOperation
+									r.summary = "List a user's login attempts, newest first"
+									r.operationID = "listLoginHistory"
+									r.operationGroup = ""
+									r.pathPattern = "/users/{userId}/login-history"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'm': // Prefix: "mfa"
+
+							if l := len("mfa"); len(elem) >= l && elem[0:l] == "mfa" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = ResetUserMfaOperation
+									r.summary = "Turn off a user's MFA so they can enrol again"
+									r.operationID = "resetUserMfa"
+									r.operationGroup = ""
+									r.pathPattern = "/users/{userId}/mfa"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'p': // Prefix: "purge"
+
+							if l := len("purge"); len(elem) >= l && elem[0:l] == "purge" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = PurgeUserOperation
+									r.summary = "Permanently remove a deleted user"
+									r.operationID = "purgeUser"
+									r.operationGroup = ""
+									r.pathPattern = "/users/{userId}/purge"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'r': // Prefix: "restore"
+
+							if l := len("restore"); len(elem) >= l && elem[0:l] == "restore" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = RestoreUserOperation
+									r.summary = "Restore a deleted user"
+									r.operationID = "restoreUser"
+									r.operationGroup = ""
+									r.pathPattern = "/users/{userId}/restore"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 's': // Prefix: "sessions"
+
+							if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = RevokeUserSessionsOperation
+									r.summary = "Revoke all of a user's sessions"
+									r.operationID = "revokeUserSessions"
+									r.operationGroup = ""
+									r.pathPattern = "/users/{userId}/sessions"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = ListUserSessionsOperation
+									r.summary = "List a user's active sessions"
+									r.operationID = "listUserSessions"
+									r.operationGroup = ""
+									r.pathPattern = "/users/{userId}/sessions"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "sessionId"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[1] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = RevokeUserSessionOperation
+										r.summary = "Revoke a single session"
+										r.operationID = "revokeUserSession"
+										r.operationGroup = ""
+										r.pathPattern = "/users/{userId}/sessions/{sessionId}"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						case 'u': // Prefix: "unlock"
+
+							if l := len("unlock"); len(elem) >= l && elem[0:l] == "unlock" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = UnlockUserOperation
+									r.summary = "Clear failed login attempts and lift a lockout"
+									r.operationID = "unlockUser"
+									r.operationGroup = ""
+									r.pathPattern = "/users/{userId}/unlock"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
 
 				}
 
@@ -3,12 +3,246 @@
 package api
 
 import (
+	"io"
+	"net/url"
 	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/google/uuid"
+	ht "github.com/ogen-go/ogen/http"
 )
 
+// AcceptInvitationNotFound is response for AcceptInvitation operation.
+type AcceptInvitationNotFound struct{}
+
+func (*AcceptInvitationNotFound) acceptInvitationRes() {}
+
+// Ref: #/components/schemas/AcceptInvitationRequest
+type AcceptInvitationRequest struct {
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	// Must satisfy the password policy.
+	Password string `json:"password"`
+}
+
+// GetFirstName returns the value of FirstName.
+func (s *AcceptInvitationRequest) GetFirstName() string {
+	return s.FirstName
+}
+
+// GetLastName returns the value of LastName.
+func (s *AcceptInvitationRequest) GetLastName() string {
+	return s.LastName
+}
+
+// GetPassword returns the value of Password.
+func (s *AcceptInvitationRequest) GetPassword() string {
+	return s.Password
+}
+
+// SetFirstName sets the value of FirstName.
+func (s *AcceptInvitationRequest) SetFirstName(val string) {
+	s.FirstName = val
+}
+
+// SetLastName sets the value of LastName.
+func (s *AcceptInvitationRequest) SetLastName(val string) {
+	s.LastName = val
+}
+
+// SetPassword sets the value of Password.
+func (s *AcceptInvitationRequest) SetPassword(val string) {
+	s.Password = val
+}
+
+// Ref: #/components/schemas/ApiKey
+type ApiKey struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	// Leading characters of the secret, to tell keys apart.
+	Prefix     string        `json:"prefix"`
+	Scopes     []ApiKeyScope `json:"scopes"`
+	CreatedAt  time.Time     `json:"createdAt"`
+	ExpiresAt  OptDateTime   `json:"expiresAt"`
+	LastUsedAt OptDateTime   `json:"lastUsedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *ApiKey) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *ApiKey) GetName() string {
+	return s.Name
+}
+
+// GetPrefix returns the value of Prefix.
+func (s *ApiKey) GetPrefix() string {
+	return s.Prefix
+}
+
+// GetScopes returns the value of Scopes.
+func (s *ApiKey) GetScopes() []ApiKeyScope {
+	return s.Scopes
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ApiKey) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *ApiKey) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *ApiKey) GetLastUsedAt() OptDateTime {
+	return s.LastUsedAt
+}
+
+// SetID sets the value of ID.
+func (s *ApiKey) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *ApiKey) SetName(val string) {
+	s.Name = val
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *ApiKey) SetPrefix(val string) {
+	s.Prefix = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *ApiKey) SetScopes(val []ApiKeyScope) {
+	s.Scopes = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ApiKey) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *ApiKey) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *ApiKey) SetLastUsedAt(val OptDateTime) {
+	s.LastUsedAt = val
+}
+
+// Ref: #/components/schemas/ApiKeyListResponse
+type ApiKeyListResponse struct {
+	Data []ApiKey `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *ApiKeyListResponse) GetData() []ApiKey {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *ApiKeyListResponse) SetData(val []ApiKey) {
+	s.Data = val
+}
+
+// Ref: #/components/schemas/ApiKeyScope
+type ApiKeyScope string
+
+const (
+	ApiKeyScopeTasksRead     ApiKeyScope = "tasks:read"
+	ApiKeyScopeTasksWrite    ApiKeyScope = "tasks:write"
+	ApiKeyScopeUsersRead     ApiKeyScope = "users:read"
+	ApiKeyScopeUsersWrite    ApiKeyScope = "users:write"
+	ApiKeyScopeAppsRead      ApiKeyScope = "apps:read"
+	ApiKeyScopeAppsWrite     ApiKeyScope = "apps:write"
+	ApiKeyScopeChatsRead     ApiKeyScope = "chats:read"
+	ApiKeyScopeChatsWrite    ApiKeyScope = "chats:write"
+	ApiKeyScopeDashboardRead ApiKeyScope = "dashboard:read"
+)
+
+// AllValues returns all ApiKeyScope values.
+func (ApiKeyScope) AllValues() []ApiKeyScope {
+	return []ApiKeyScope{
+		ApiKeyScopeTasksRead,
+		ApiKeyScopeTasksWrite,
+		ApiKeyScopeUsersRead,
+		ApiKeyScopeUsersWrite,
+		ApiKeyScopeAppsRead,
+		ApiKeyScopeAppsWrite,
+		ApiKeyScopeChatsRead,
+		ApiKeyScopeChatsWrite,
+		ApiKeyScopeDashboardRead,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ApiKeyScope) MarshalText() ([]byte, error) {
+	switch s {
+	case ApiKeyScopeTasksRead:
+		return []byte(s), nil
+	case ApiKeyScopeTasksWrite:
+		return []byte(s), nil
+	case ApiKeyScopeUsersRead:
+		return []byte(s), nil
+	case ApiKeyScopeUsersWrite:
+		return []byte(s), nil
+	case ApiKeyScopeAppsRead:
+		return []byte(s), nil
+	case ApiKeyScopeAppsWrite:
+		return []byte(s), nil
+	case ApiKeyScopeChatsRead:
+		return []byte(s), nil
+	case ApiKeyScopeChatsWrite:
+		return []byte(s), nil
+	case ApiKeyScopeDashboardRead:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ApiKeyScope) UnmarshalText(data []byte) error {
+	switch ApiKeyScope(data) {
+	case ApiKeyScopeTasksRead:
+		*s = ApiKeyScopeTasksRead
+		return nil
+	case ApiKeyScopeTasksWrite:
+		*s = ApiKeyScopeTasksWrite
+		return nil
+	case ApiKeyScopeUsersRead:
+		*s = ApiKeyScopeUsersRead
+		return nil
+	case ApiKeyScopeUsersWrite:
+		*s = ApiKeyScopeUsersWrite
+		return nil
+	case ApiKeyScopeAppsRead:
+		*s = ApiKeyScopeAppsRead
+		return nil
+	case ApiKeyScopeAppsWrite:
+		*s = ApiKeyScopeAppsWrite
+		return nil
+	case ApiKeyScopeChatsRead:
+		*s = ApiKeyScopeChatsRead
+		return nil
+	case ApiKeyScopeChatsWrite:
+		*s = ApiKeyScopeChatsWrite
+		return nil
+	case ApiKeyScopeDashboardRead:
+		*s = ApiKeyScopeDashboardRead
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/App
 type App struct {
 	ID   string `json:"id"`
@@ -90,7 +324,8 @@ type AuthUser struct {
 	Email     string   `json:"email"`
 	Role      []string `json:"role"`
 	// Token expiry timestamp.
-	Exp int `json:"exp"`
+	Exp          int             `json:"exp"`
+	Impersonator OptImpersonator `json:"impersonator"`
 }
 
 // GetAccountNo returns the value of AccountNo.
@@ -113,6 +348,11 @@ func (s *AuthUser) GetExp() int {
 	return s.Exp
 }
 
+// GetImpersonator returns the value of Impersonator.
+func (s *AuthUser) GetImpersonator() OptImpersonator {
+	return s.Impersonator
+}
+
 // SetAccountNo sets the value of AccountNo.
 func (s *AuthUser) SetAccountNo(val string) {
 	s.AccountNo = val
@@ -133,8 +373,279 @@ func (s *AuthUser) SetExp(val int) {
 	s.Exp = val
 }
 
+// SetImpersonator sets the value of Impersonator.
+func (s *AuthUser) SetImpersonator(val OptImpersonator) {
+	s.Impersonator = val
+}
+
 func (*AuthUser) getCurrentUserRes() {}
 
+type BearerAuth struct {
+	Token string
+	Roles []string
+}
+
+// GetToken returns the value of Token.
+func (s *BearerAuth) GetToken() string {
+	return s.Token
+}
+
+// GetRoles returns the value of Roles.
+func (s *BearerAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetToken sets the value of Token.
+func (s *BearerAuth) SetToken(val string) {
+	s.Token = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *BearerAuth) SetRoles(val []string) {
+	s.Roles = val
+}
+
+// Ref: #/components/schemas/BulkUserAction
+type BulkUserAction string
+
+const (
+	BulkUserActionSetStatus BulkUserAction = "setStatus"
+	BulkUserActionSetRole   BulkUserAction = "setRole"
+	BulkUserActionDelete    BulkUserAction = "delete"
+)
+
+// AllValues returns all BulkUserAction values.
+func (BulkUserAction) AllValues() []BulkUserAction {
+	return []BulkUserAction{
+		BulkUserActionSetStatus,
+		BulkUserActionSetRole,
+		BulkUserActionDelete,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s BulkUserAction) MarshalText() ([]byte, error) {
+	switch s {
+	case BulkUserActionSetStatus:
+		return []byte(s), nil
+	case BulkUserActionSetRole:
+		return []byte(s), nil
+	case BulkUserActionDelete:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BulkUserAction) UnmarshalText(data []byte) error {
+	switch BulkUserAction(data) {
+	case BulkUserActionSetStatus:
+		*s = BulkUserActionSetStatus
+		return nil
+	case BulkUserActionSetRole:
+		*s = BulkUserActionSetRole
+		return nil
+	case BulkUserActionDelete:
+		*s = BulkUserActionDelete
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type BulkUserActionBadRequest ErrorResponse
+
+func (*BulkUserActionBadRequest) bulkUserActionRes() {}
+
+type BulkUserActionConflict ErrorResponse
+
+func (*BulkUserActionConflict) bulkUserActionRes() {}
+
+// Ref: #/components/schemas/BulkUserActionOutcome
+type BulkUserActionOutcome string
+
+const (
+	BulkUserActionOutcomeUpdated   BulkUserActionOutcome = "updated"
+	BulkUserActionOutcomeNotFound  BulkUserActionOutcome = "not_found"
+	BulkUserActionOutcomeForbidden BulkUserActionOutcome = "forbidden"
+)
+
+// AllValues returns all BulkUserActionOutcome values.
+func (BulkUserActionOutcome) AllValues() []BulkUserActionOutcome {
+	return []BulkUserActionOutcome{
+		BulkUserActionOutcomeUpdated,
+		BulkUserActionOutcomeNotFound,
+		BulkUserActionOutcomeForbidden,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s BulkUserActionOutcome) MarshalText() ([]byte, error) {
+	switch s {
+	case BulkUserActionOutcomeUpdated:
+		return []byte(s), nil
+	case BulkUserActionOutcomeNotFound:
+		return []byte(s), nil
+	case BulkUserActionOutcomeForbidden:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BulkUserActionOutcome) UnmarshalText(data []byte) error {
+	switch BulkUserActionOutcome(data) {
+	case BulkUserActionOutcomeUpdated:
+		*s = BulkUserActionOutcomeUpdated
+		return nil
+	case BulkUserActionOutcomeNotFound:
+		*s = BulkUserActionOutcomeNotFound
+		return nil
+	case BulkUserActionOutcomeForbidden:
+		*s = BulkUserActionOutcomeForbidden
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/BulkUserActionRequest
+type BulkUserActionRequest struct {
+	UserIds []uuid.UUID    `json:"userIds"`
+	Action  BulkUserAction `json:"action"`
+	Status  OptUserStatus  `json:"status"`
+	Role    OptUserRole    `json:"role"`
+}
+
+// GetUserIds returns the value of UserIds.
+func (s *BulkUserActionRequest) GetUserIds() []uuid.UUID {
+	return s.UserIds
+}
+
+// GetAction returns the value of Action.
+func (s *BulkUserActionRequest) GetAction() BulkUserAction {
+	return s.Action
+}
+
+// GetStatus returns the value of Status.
+func (s *BulkUserActionRequest) GetStatus() OptUserStatus {
+	return s.Status
+}
+
+// GetRole returns the value of Role.
+func (s *BulkUserActionRequest) GetRole() OptUserRole {
+	return s.Role
+}
+
+// SetUserIds sets the value of UserIds.
+func (s *BulkUserActionRequest) SetUserIds(val []uuid.UUID) {
+	s.UserIds = val
+}
+
+// SetAction sets the value of Action.
+func (s *BulkUserActionRequest) SetAction(val BulkUserAction) {
+	s.Action = val
+}
+
+// SetStatus sets the value of Status.
+func (s *BulkUserActionRequest) SetStatus(val OptUserStatus) {
+	s.Status = val
+}
+
+// SetRole sets the value of Role.
+func (s *BulkUserActionRequest) SetRole(val OptUserRole) {
+	s.Role = val
+}
+
+// Ref: #/components/schemas/BulkUserActionResponse
+type BulkUserActionResponse struct {
+	Results []BulkUserActionResult `json:"results"`
+}
+
+// GetResults returns the value of Results.
+func (s *BulkUserActionResponse) GetResults() []BulkUserActionResult {
+	return s.Results
+}
+
+// SetResults sets the value of Results.
+func (s *BulkUserActionResponse) SetResults(val []BulkUserActionResult) {
+	s.Results = val
+}
+
+func (*BulkUserActionResponse) bulkUserActionRes() {}
+
+// Ref: #/components/schemas/BulkUserActionResult
+type BulkUserActionResult struct {
+	UserId uuid.UUID             `json:"userId"`
+	Result BulkUserActionOutcome `json:"result"`
+	// Why the action was not applied to this user.
+	Reason OptString `json:"reason"`
+}
+
+// GetUserId returns the value of UserId.
+func (s *BulkUserActionResult) GetUserId() uuid.UUID {
+	return s.UserId
+}
+
+// GetResult returns the value of Result.
+func (s *BulkUserActionResult) GetResult() BulkUserActionOutcome {
+	return s.Result
+}
+
+// GetReason returns the value of Reason.
+func (s *BulkUserActionResult) GetReason() OptString {
+	return s.Reason
+}
+
+// SetUserId sets the value of UserId.
+func (s *BulkUserActionResult) SetUserId(val uuid.UUID) {
+	s.UserId = val
+}
+
+// SetResult sets the value of Result.
+func (s *BulkUserActionResult) SetResult(val BulkUserActionOutcome) {
+	s.Result = val
+}
+
+// SetReason sets the value of Reason.
+func (s *BulkUserActionResult) SetReason(val OptString) {
+	s.Reason = val
+}
+
+// ChangePasswordNoContent is response for ChangePassword operation.
+type ChangePasswordNoContent struct{}
+
+func (*ChangePasswordNoContent) changePasswordRes() {}
+
+// Ref: #/components/schemas/ChangePasswordRequest
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword"`
+	// Must satisfy the password policy.
+	NewPassword string `json:"newPassword"`
+}
+
+// GetCurrentPassword returns the value of CurrentPassword.
+func (s *ChangePasswordRequest) GetCurrentPassword() string {
+	return s.CurrentPassword
+}
+
+// GetNewPassword returns the value of NewPassword.
+func (s *ChangePasswordRequest) GetNewPassword() string {
+	return s.NewPassword
+}
+
+// SetCurrentPassword sets the value of CurrentPassword.
+func (s *ChangePasswordRequest) SetCurrentPassword(val string) {
+	s.CurrentPassword = val
+}
+
+// SetNewPassword sets the value of NewPassword.
+func (s *ChangePasswordRequest) SetNewPassword(val string) {
+	s.NewPassword = val
+}
+
 // Ref: #/components/schemas/ChatConversation
 type ChatConversation struct {
 	ID       string        `json:"id"`
@@ -259,14 +770,79 @@ func (s *ChatMessage) SetTimestamp(val time.Time) {
 	s.Timestamp = val
 }
 
-// Ref: #/components/schemas/CreateTaskRequest
-type CreateTaskRequest struct {
-	Title       string       `json:"title"`
-	Status      TaskStatus   `json:"status"`
-	Label       TaskLabel    `json:"label"`
-	Priority    TaskPriority `json:"priority"`
-	Assignee    OptString    `json:"assignee"`
-	Description OptString    `json:"description"`
+type CompleteSsoForbidden ErrorResponse
+
+func (*CompleteSsoForbidden) completeSsoRes() {}
+
+type CompleteSsoNotFound ErrorResponse
+
+func (*CompleteSsoNotFound) completeSsoRes() {}
+
+type CompleteSsoUnauthorized ErrorResponse
+
+func (*CompleteSsoUnauthorized) completeSsoRes() {}
+
+// Ref: #/components/schemas/ConfirmMfaRequest
+type ConfirmMfaRequest struct {
+	Code string `json:"code"`
+}
+
+// GetCode returns the value of Code.
+func (s *ConfirmMfaRequest) GetCode() string {
+	return s.Code
+}
+
+// SetCode sets the value of Code.
+func (s *ConfirmMfaRequest) SetCode(val string) {
+	s.Code = val
+}
+
+// Ref: #/components/schemas/CreateApiKeyRequest
+type CreateApiKeyRequest struct {
+	Name   string        `json:"name"`
+	Scopes []ApiKeyScope `json:"scopes"`
+	// Omit for a key that does not expire.
+	ExpiresAt OptDateTime `json:"expiresAt"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateApiKeyRequest) GetName() string {
+	return s.Name
+}
+
+// GetScopes returns the value of Scopes.
+func (s *CreateApiKeyRequest) GetScopes() []ApiKeyScope {
+	return s.Scopes
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *CreateApiKeyRequest) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
+}
+
+// SetName sets the value of Name.
+func (s *CreateApiKeyRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *CreateApiKeyRequest) SetScopes(val []ApiKeyScope) {
+	s.Scopes = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *CreateApiKeyRequest) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
+}
+
+// Ref: #/components/schemas/CreateTaskRequest
+type CreateTaskRequest struct {
+	Title       string       `json:"title"`
+	Status      TaskStatus   `json:"status"`
+	Label       TaskLabel    `json:"label"`
+	Priority    TaskPriority `json:"priority"`
+	Assignee    OptString    `json:"assignee"`
+	Description OptString    `json:"description"`
 	DueDate     OptDateTime  `json:"dueDate"`
 }
 
@@ -342,11 +918,16 @@ func (s *CreateTaskRequest) SetDueDate(val OptDateTime) {
 
 // Ref: #/components/schemas/CreateUserRequest
 type CreateUserRequest struct {
-	FirstName   string    `json:"firstName"`
-	LastName    string    `json:"lastName"`
-	Email       string    `json:"email"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Email     string `json:"email"`
+	// Defaults to the part of the email before the @, with a number appended if that is taken.
+	Username    OptString `json:"username"`
 	PhoneNumber OptString `json:"phoneNumber"`
 	Role        UserRole  `json:"role"`
+	// Initial password, subject to the password policy. When omitted the user is emailed a link to set
+	// one.
+	Password OptString `json:"password"`
 }
 
 // GetFirstName returns the value of FirstName.
@@ -364,6 +945,11 @@ func (s *CreateUserRequest) GetEmail() string {
 	return s.Email
 }
 
+// GetUsername returns the value of Username.
+func (s *CreateUserRequest) GetUsername() OptString {
+	return s.Username
+}
+
 // GetPhoneNumber returns the value of PhoneNumber.
 func (s *CreateUserRequest) GetPhoneNumber() OptString {
 	return s.PhoneNumber
@@ -374,6 +960,11 @@ func (s *CreateUserRequest) GetRole() UserRole {
 	return s.Role
 }
 
+// GetPassword returns the value of Password.
+func (s *CreateUserRequest) GetPassword() OptString {
+	return s.Password
+}
+
 // SetFirstName sets the value of FirstName.
 func (s *CreateUserRequest) SetFirstName(val string) {
 	s.FirstName = val
@@ -389,6 +980,11 @@ func (s *CreateUserRequest) SetEmail(val string) {
 	s.Email = val
 }
 
+// SetUsername sets the value of Username.
+func (s *CreateUserRequest) SetUsername(val OptString) {
+	s.Username = val
+}
+
 // SetPhoneNumber sets the value of PhoneNumber.
 func (s *CreateUserRequest) SetPhoneNumber(val OptString) {
 	s.PhoneNumber = val
@@ -399,6 +995,40 @@ func (s *CreateUserRequest) SetRole(val UserRole) {
 	s.Role = val
 }
 
+// SetPassword sets the value of Password.
+func (s *CreateUserRequest) SetPassword(val OptString) {
+	s.Password = val
+}
+
+// Ref: #/components/schemas/CreatedApiKey
+type CreatedApiKey struct {
+	ApiKey ApiKey `json:"apiKey"`
+	// The key itself; it cannot be retrieved again.
+	Secret string `json:"secret"`
+}
+
+// GetApiKey returns the value of ApiKey.
+func (s *CreatedApiKey) GetApiKey() ApiKey {
+	return s.ApiKey
+}
+
+// GetSecret returns the value of Secret.
+func (s *CreatedApiKey) GetSecret() string {
+	return s.Secret
+}
+
+// SetApiKey sets the value of ApiKey.
+func (s *CreatedApiKey) SetApiKey(val ApiKey) {
+	s.ApiKey = val
+}
+
+// SetSecret sets the value of Secret.
+func (s *CreatedApiKey) SetSecret(val string) {
+	s.Secret = val
+}
+
+func (*CreatedApiKey) createApiKeyRes() {}
+
 // Ref: #/components/schemas/DashboardOverview
 type DashboardOverview struct {
 	Data []DashboardOverviewDataItem `json:"data"`
@@ -615,6 +1245,8 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 	// Full error chain for debugging (empty in production).
 	Details OptString `json:"details"`
+	// Field-level validation failures, e.g., password policy violations.
+	Fields []FieldError `json:"fields"`
 }
 
 // GetCode returns the value of Code.
@@ -632,6 +1264,11 @@ func (s *ErrorResponse) GetDetails() OptString {
 	return s.Details
 }
 
+// GetFields returns the value of Fields.
+func (s *ErrorResponse) GetFields() []FieldError {
+	return s.Fields
+}
+
 // SetCode sets the value of Code.
 func (s *ErrorResponse) SetCode(val string) {
 	s.Code = val
@@ -647,8 +1284,202 @@ func (s *ErrorResponse) SetDetails(val OptString) {
 	s.Details = val
 }
 
-func (*ErrorResponse) getTaskRes() {}
-func (*ErrorResponse) loginRes()   {}
+// SetFields sets the value of Fields.
+func (s *ErrorResponse) SetFields(val []FieldError) {
+	s.Fields = val
+}
+
+func (*ErrorResponse) changePasswordRes()    {}
+func (*ErrorResponse) confirmMfaRes()        {}
+func (*ErrorResponse) createApiKeyRes()      {}
+func (*ErrorResponse) deleteTaskRes()        {}
+func (*ErrorResponse) deleteUserRes()        {}
+func (*ErrorResponse) enrollMfaRes()         {}
+func (*ErrorResponse) getTaskRes()           {}
+func (*ErrorResponse) impersonateUserRes()   {}
+func (*ErrorResponse) importUsersRes()       {}
+func (*ErrorResponse) purgeUserRes()         {}
+func (*ErrorResponse) refreshTokenRes()      {}
+func (*ErrorResponse) resendInvitationRes()  {}
+func (*ErrorResponse) resetPasswordRes()     {}
+func (*ErrorResponse) restoreUserRes()       {}
+func (*ErrorResponse) revokeInvitationRes()  {}
+func (*ErrorResponse) startSsoRes()          {}
+func (*ErrorResponse) stopImpersonationRes() {}
+func (*ErrorResponse) updateTaskRes()        {}
+func (*ErrorResponse) updateUserRes()        {}
+func (*ErrorResponse) verifyMfaRes()         {}
+
+// ErrorResponseHeaders wraps ErrorResponse with response headers.
+type ErrorResponseHeaders struct {
+	RetryAfter OptInt
+	Response   ErrorResponse
+}
+
+// GetRetryAfter returns the value of RetryAfter.
+func (s *ErrorResponseHeaders) GetRetryAfter() OptInt {
+	return s.RetryAfter
+}
+
+// GetResponse returns the value of Response.
+func (s *ErrorResponseHeaders) GetResponse() ErrorResponse {
+	return s.Response
+}
+
+// SetRetryAfter sets the value of RetryAfter.
+func (s *ErrorResponseHeaders) SetRetryAfter(val OptInt) {
+	s.RetryAfter = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ErrorResponseHeaders) SetResponse(val ErrorResponse) {
+	s.Response = val
+}
+
+func (*ErrorResponseHeaders) loginRes() {}
+
+type ExportUsersOKApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ExportUsersOKApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// ExportUsersOKApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheetHeaders wraps ExportUsersOKApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet with response headers.
+type ExportUsersOKApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheetHeaders struct {
+	ContentDisposition OptString
+	Response           ExportUsersOKApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *ExportUsersOKApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheetHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
+}
+
+// GetResponse returns the value of Response.
+func (s *ExportUsersOKApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheetHeaders) GetResponse() ExportUsersOKApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet {
+	return s.Response
+}
+
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *ExportUsersOKApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheetHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ExportUsersOKApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheetHeaders) SetResponse(val ExportUsersOKApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet) {
+	s.Response = val
+}
+
+func (*ExportUsersOKApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheetHeaders) exportUsersRes() {
+}
+
+type ExportUsersOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ExportUsersOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// ExportUsersOKTextCsvHeaders wraps ExportUsersOKTextCsv with response headers.
+type ExportUsersOKTextCsvHeaders struct {
+	ContentDisposition OptString
+	Response           ExportUsersOKTextCsv
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *ExportUsersOKTextCsvHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
+}
+
+// GetResponse returns the value of Response.
+func (s *ExportUsersOKTextCsvHeaders) GetResponse() ExportUsersOKTextCsv {
+	return s.Response
+}
+
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *ExportUsersOKTextCsvHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ExportUsersOKTextCsvHeaders) SetResponse(val ExportUsersOKTextCsv) {
+	s.Response = val
+}
+
+func (*ExportUsersOKTextCsvHeaders) exportUsersRes() {}
+
+// Ref: #/components/schemas/FieldError
+type FieldError struct {
+	// Request field that failed validation, e.g., "password".
+	Field string `json:"field"`
+	// Violation code, e.g., "PASSWORD_TOO_SHORT".
+	Code string `json:"code"`
+	// Human-readable explanation of the violation.
+	Message string `json:"message"`
+}
+
+// GetField returns the value of Field.
+func (s *FieldError) GetField() string {
+	return s.Field
+}
+
+// GetCode returns the value of Code.
+func (s *FieldError) GetCode() string {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *FieldError) GetMessage() string {
+	return s.Message
+}
+
+// SetField sets the value of Field.
+func (s *FieldError) SetField(val string) {
+	s.Field = val
+}
+
+// SetCode sets the value of Code.
+func (s *FieldError) SetCode(val string) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *FieldError) SetMessage(val string) {
+	s.Message = val
+}
+
+// ForgotPasswordNoContent is response for ForgotPassword operation.
+type ForgotPasswordNoContent struct{}
+
+// Ref: #/components/schemas/ForgotPasswordRequest
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+// GetEmail returns the value of Email.
+func (s *ForgotPasswordRequest) GetEmail() string {
+	return s.Email
+}
+
+// SetEmail sets the value of Email.
+func (s *ForgotPasswordRequest) SetEmail(val string) {
+	s.Email = val
+}
 
 // GetChatNotFound is response for GetChat operation.
 type GetChatNotFound struct{}
@@ -660,15 +1491,148 @@ type GetCurrentUserUnauthorized struct{}
 
 func (*GetCurrentUserUnauthorized) getCurrentUserRes() {}
 
+// GetInvitationNotFound is response for GetInvitation operation.
+type GetInvitationNotFound struct{}
+
+func (*GetInvitationNotFound) getInvitationRes() {}
+
 // GetUserNotFound is response for GetUser operation.
 type GetUserNotFound struct{}
 
 func (*GetUserNotFound) getUserRes() {}
 
+// Ref: #/components/schemas/ImpersonateRequest
+type ImpersonateRequest struct {
+	// Why the user is being impersonated, kept in the audit trail.
+	Reason string `json:"reason"`
+}
+
+// GetReason returns the value of Reason.
+func (s *ImpersonateRequest) GetReason() string {
+	return s.Reason
+}
+
+// SetReason sets the value of Reason.
+func (s *ImpersonateRequest) SetReason(val string) {
+	s.Reason = val
+}
+
+// ImpersonateUserNotFound is response for ImpersonateUser operation.
+type ImpersonateUserNotFound struct{}
+
+func (*ImpersonateUserNotFound) impersonateUserRes() {}
+
+// The real identity behind an impersonation token.
+// Ref: #/components/schemas/Impersonator
+type Impersonator struct {
+	AccountNo string `json:"accountNo"`
+	Email     string `json:"email"`
+}
+
+// GetAccountNo returns the value of AccountNo.
+func (s *Impersonator) GetAccountNo() string {
+	return s.AccountNo
+}
+
+// GetEmail returns the value of Email.
+func (s *Impersonator) GetEmail() string {
+	return s.Email
+}
+
+// SetAccountNo sets the value of AccountNo.
+func (s *Impersonator) SetAccountNo(val string) {
+	s.AccountNo = val
+}
+
+// SetEmail sets the value of Email.
+func (s *Impersonator) SetEmail(val string) {
+	s.Email = val
+}
+
+type ImportUsersReq struct {
+	File ht.MultipartFile `json:"file"`
+	// Only validate the file and report per-row errors.
+	DryRun OptBool `json:"dryRun"`
+	// Create the users as invited and email them an invitation instead of setting passwords. The
+	// password and status columns must then be empty.
+	SendInvitations OptBool `json:"sendInvitations"`
+}
+
+// GetFile returns the value of File.
+func (s *ImportUsersReq) GetFile() ht.MultipartFile {
+	return s.File
+}
+
+// GetDryRun returns the value of DryRun.
+func (s *ImportUsersReq) GetDryRun() OptBool {
+	return s.DryRun
+}
+
+// GetSendInvitations returns the value of SendInvitations.
+func (s *ImportUsersReq) GetSendInvitations() OptBool {
+	return s.SendInvitations
+}
+
+// SetFile sets the value of File.
+func (s *ImportUsersReq) SetFile(val ht.MultipartFile) {
+	s.File = val
+}
+
+// SetDryRun sets the value of DryRun.
+func (s *ImportUsersReq) SetDryRun(val OptBool) {
+	s.DryRun = val
+}
+
+// SetSendInvitations sets the value of SendInvitations.
+func (s *ImportUsersReq) SetSendInvitations(val OptBool) {
+	s.SendInvitations = val
+}
+
+// Ref: #/components/schemas/InvitationDetails
+type InvitationDetails struct {
+	Email     string    `json:"email"`
+	Role      UserRole  `json:"role"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// GetEmail returns the value of Email.
+func (s *InvitationDetails) GetEmail() string {
+	return s.Email
+}
+
+// GetRole returns the value of Role.
+func (s *InvitationDetails) GetRole() UserRole {
+	return s.Role
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *InvitationDetails) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// SetEmail sets the value of Email.
+func (s *InvitationDetails) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetRole sets the value of Role.
+func (s *InvitationDetails) SetRole(val UserRole) {
+	s.Role = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *InvitationDetails) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+func (*InvitationDetails) getInvitationRes() {}
+
 // Ref: #/components/schemas/InviteUserRequest
 type InviteUserRequest struct {
-	Email string   `json:"email"`
-	Role  UserRole `json:"role"`
+	Email string `json:"email"`
+	// Defaults to the part of the email before the @, with a number appended if that is taken.
+	Username OptString `json:"username"`
+	Role     UserRole  `json:"role"`
 }
 
 // GetEmail returns the value of Email.
@@ -676,6 +1640,11 @@ func (s *InviteUserRequest) GetEmail() string {
 	return s.Email
 }
 
+// GetUsername returns the value of Username.
+func (s *InviteUserRequest) GetUsername() OptString {
+	return s.Username
+}
+
 // GetRole returns the value of Role.
 func (s *InviteUserRequest) GetRole() UserRole {
 	return s.Role
@@ -686,32 +1655,255 @@ func (s *InviteUserRequest) SetEmail(val string) {
 	s.Email = val
 }
 
+// SetUsername sets the value of Username.
+func (s *InviteUserRequest) SetUsername(val OptString) {
+	s.Username = val
+}
+
 // SetRole sets the value of Role.
 func (s *InviteUserRequest) SetRole(val UserRole) {
 	s.Role = val
 }
 
-type ListAppsSort string
+type ListAppsSort string
+
+const (
+	ListAppsSortAsc  ListAppsSort = "asc"
+	ListAppsSortDesc ListAppsSort = "desc"
+)
+
+// AllValues returns all ListAppsSort values.
+func (ListAppsSort) AllValues() []ListAppsSort {
+	return []ListAppsSort{
+		ListAppsSortAsc,
+		ListAppsSortDesc,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListAppsSort) MarshalText() ([]byte, error) {
+	switch s {
+	case ListAppsSortAsc:
+		return []byte(s), nil
+	case ListAppsSortDesc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListAppsSort) UnmarshalText(data []byte) error {
+	switch ListAppsSort(data) {
+	case ListAppsSortAsc:
+		*s = ListAppsSortAsc
+		return nil
+	case ListAppsSortDesc:
+		*s = ListAppsSortDesc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ListAppsType string
+
+const (
+	ListAppsTypeAll          ListAppsType = "all"
+	ListAppsTypeConnected    ListAppsType = "connected"
+	ListAppsTypeNotConnected ListAppsType = "notConnected"
+)
+
+// AllValues returns all ListAppsType values.
+func (ListAppsType) AllValues() []ListAppsType {
+	return []ListAppsType{
+		ListAppsTypeAll,
+		ListAppsTypeConnected,
+		ListAppsTypeNotConnected,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListAppsType) MarshalText() ([]byte, error) {
+	switch s {
+	case ListAppsTypeAll:
+		return []byte(s), nil
+	case ListAppsTypeConnected:
+		return []byte(s), nil
+	case ListAppsTypeNotConnected:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListAppsType) UnmarshalText(data []byte) error {
+	switch ListAppsType(data) {
+	case ListAppsTypeAll:
+		*s = ListAppsTypeAll
+		return nil
+	case ListAppsTypeConnected:
+		*s = ListAppsTypeConnected
+		return nil
+	case ListAppsTypeNotConnected:
+		*s = ListAppsTypeNotConnected
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// ListLoginHistoryNotFound is response for ListLoginHistory operation.
+type ListLoginHistoryNotFound struct{}
+
+func (*ListLoginHistoryNotFound) listLoginHistoryRes() {}
+
+// ListUserSessionsNotFound is response for ListUserSessions operation.
+type ListUserSessionsNotFound struct{}
+
+func (*ListUserSessionsNotFound) listUserSessionsRes() {}
+
+// Ref: #/components/schemas/LoginEvent
+type LoginEvent struct {
+	ID      uuid.UUID    `json:"id"`
+	Method  LoginMethod  `json:"method"`
+	Outcome LoginOutcome `json:"outcome"`
+	// Why the attempt failed, e.g. "invalid_credentials" or "account_suspended".
+	FailureReason OptString `json:"failureReason"`
+	IpAddress     OptString `json:"ipAddress"`
+	UserAgent     OptString `json:"userAgent"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// GetID returns the value of ID.
+func (s *LoginEvent) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetMethod returns the value of Method.
+func (s *LoginEvent) GetMethod() LoginMethod {
+	return s.Method
+}
+
+// GetOutcome returns the value of Outcome.
+func (s *LoginEvent) GetOutcome() LoginOutcome {
+	return s.Outcome
+}
+
+// GetFailureReason returns the value of FailureReason.
+func (s *LoginEvent) GetFailureReason() OptString {
+	return s.FailureReason
+}
+
+// GetIpAddress returns the value of IpAddress.
+func (s *LoginEvent) GetIpAddress() OptString {
+	return s.IpAddress
+}
+
+// GetUserAgent returns the value of UserAgent.
+func (s *LoginEvent) GetUserAgent() OptString {
+	return s.UserAgent
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *LoginEvent) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *LoginEvent) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetMethod sets the value of Method.
+func (s *LoginEvent) SetMethod(val LoginMethod) {
+	s.Method = val
+}
+
+// SetOutcome sets the value of Outcome.
+func (s *LoginEvent) SetOutcome(val LoginOutcome) {
+	s.Outcome = val
+}
+
+// SetFailureReason sets the value of FailureReason.
+func (s *LoginEvent) SetFailureReason(val OptString) {
+	s.FailureReason = val
+}
+
+// SetIpAddress sets the value of IpAddress.
+func (s *LoginEvent) SetIpAddress(val OptString) {
+	s.IpAddress = val
+}
+
+// SetUserAgent sets the value of UserAgent.
+func (s *LoginEvent) SetUserAgent(val OptString) {
+	s.UserAgent = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *LoginEvent) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+type LoginForbidden ErrorResponse
+
+func (*LoginForbidden) loginRes() {}
+
+// Ref: #/components/schemas/LoginHistoryResponse
+type LoginHistoryResponse struct {
+	Data []LoginEvent   `json:"data"`
+	Meta PaginationMeta `json:"meta"`
+}
+
+// GetData returns the value of Data.
+func (s *LoginHistoryResponse) GetData() []LoginEvent {
+	return s.Data
+}
+
+// GetMeta returns the value of Meta.
+func (s *LoginHistoryResponse) GetMeta() PaginationMeta {
+	return s.Meta
+}
+
+// SetData sets the value of Data.
+func (s *LoginHistoryResponse) SetData(val []LoginEvent) {
+	s.Data = val
+}
+
+// SetMeta sets the value of Meta.
+func (s *LoginHistoryResponse) SetMeta(val PaginationMeta) {
+	s.Meta = val
+}
+
+func (*LoginHistoryResponse) listLoginHistoryRes() {}
+
+// Ref: #/components/schemas/LoginMethod
+type LoginMethod string
 
 const (
-	ListAppsSortAsc  ListAppsSort = "asc"
-	ListAppsSortDesc ListAppsSort = "desc"
+	LoginMethodPassword LoginMethod = "password"
+	LoginMethodMfa      LoginMethod = "mfa"
+	LoginMethodSSO      LoginMethod = "sso"
 )
 
-// AllValues returns all ListAppsSort values.
-func (ListAppsSort) AllValues() []ListAppsSort {
-	return []ListAppsSort{
-		ListAppsSortAsc,
-		ListAppsSortDesc,
+// AllValues returns all LoginMethod values.
+func (LoginMethod) AllValues() []LoginMethod {
+	return []LoginMethod{
+		LoginMethodPassword,
+		LoginMethodMfa,
+		LoginMethodSSO,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s ListAppsSort) MarshalText() ([]byte, error) {
+func (s LoginMethod) MarshalText() ([]byte, error) {
 	switch s {
-	case ListAppsSortAsc:
+	case LoginMethodPassword:
 		return []byte(s), nil
-	case ListAppsSortDesc:
+	case LoginMethodMfa:
+		return []byte(s), nil
+	case LoginMethodSSO:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -719,44 +1911,48 @@ func (s ListAppsSort) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ListAppsSort) UnmarshalText(data []byte) error {
-	switch ListAppsSort(data) {
-	case ListAppsSortAsc:
-		*s = ListAppsSortAsc
+func (s *LoginMethod) UnmarshalText(data []byte) error {
+	switch LoginMethod(data) {
+	case LoginMethodPassword:
+		*s = LoginMethodPassword
 		return nil
-	case ListAppsSortDesc:
-		*s = ListAppsSortDesc
+	case LoginMethodMfa:
+		*s = LoginMethodMfa
+		return nil
+	case LoginMethodSSO:
+		*s = LoginMethodSSO
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-type ListAppsType string
+// Ref: #/components/schemas/LoginOutcome
+type LoginOutcome string
 
 const (
-	ListAppsTypeAll          ListAppsType = "all"
-	ListAppsTypeConnected    ListAppsType = "connected"
-	ListAppsTypeNotConnected ListAppsType = "notConnected"
+	LoginOutcomeSuccess     LoginOutcome = "success"
+	LoginOutcomeFailure     LoginOutcome = "failure"
+	LoginOutcomeMfaRequired LoginOutcome = "mfa_required"
 )
 
-// AllValues returns all ListAppsType values.
-func (ListAppsType) AllValues() []ListAppsType {
-	return []ListAppsType{
-		ListAppsTypeAll,
-		ListAppsTypeConnected,
-		ListAppsTypeNotConnected,
+// AllValues returns all LoginOutcome values.
+func (LoginOutcome) AllValues() []LoginOutcome {
+	return []LoginOutcome{
+		LoginOutcomeSuccess,
+		LoginOutcomeFailure,
+		LoginOutcomeMfaRequired,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s ListAppsType) MarshalText() ([]byte, error) {
+func (s LoginOutcome) MarshalText() ([]byte, error) {
 	switch s {
-	case ListAppsTypeAll:
+	case LoginOutcomeSuccess:
 		return []byte(s), nil
-	case ListAppsTypeConnected:
+	case LoginOutcomeFailure:
 		return []byte(s), nil
-	case ListAppsTypeNotConnected:
+	case LoginOutcomeMfaRequired:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -764,16 +1960,16 @@ func (s ListAppsType) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ListAppsType) UnmarshalText(data []byte) error {
-	switch ListAppsType(data) {
-	case ListAppsTypeAll:
-		*s = ListAppsTypeAll
+func (s *LoginOutcome) UnmarshalText(data []byte) error {
+	switch LoginOutcome(data) {
+	case LoginOutcomeSuccess:
+		*s = LoginOutcomeSuccess
 		return nil
-	case ListAppsTypeConnected:
-		*s = ListAppsTypeConnected
+	case LoginOutcomeFailure:
+		*s = LoginOutcomeFailure
 		return nil
-	case ListAppsTypeNotConnected:
-		*s = ListAppsTypeNotConnected
+	case LoginOutcomeMfaRequired:
+		*s = LoginOutcomeMfaRequired
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
@@ -810,6 +2006,8 @@ func (s *LoginRequest) SetPassword(val string) {
 type LoginResponse struct {
 	User        AuthUser `json:"user"`
 	AccessToken string   `json:"accessToken"`
+	// Single-use token for /auth/refresh.
+	RefreshToken OptString `json:"refreshToken"`
 }
 
 // GetUser returns the value of User.
@@ -822,6 +2020,11 @@ func (s *LoginResponse) GetAccessToken() string {
 	return s.AccessToken
 }
 
+// GetRefreshToken returns the value of RefreshToken.
+func (s *LoginResponse) GetRefreshToken() OptString {
+	return s.RefreshToken
+}
+
 // SetUser sets the value of User.
 func (s *LoginResponse) SetUser(val AuthUser) {
 	s.User = val
@@ -832,11 +2035,146 @@ func (s *LoginResponse) SetAccessToken(val string) {
 	s.AccessToken = val
 }
 
-func (*LoginResponse) loginRes() {}
+// SetRefreshToken sets the value of RefreshToken.
+func (s *LoginResponse) SetRefreshToken(val OptString) {
+	s.RefreshToken = val
+}
+
+func (*LoginResponse) completeSsoRes()     {}
+func (*LoginResponse) impersonateUserRes() {}
+func (*LoginResponse) loginRes()           {}
+func (*LoginResponse) refreshTokenRes()    {}
+func (*LoginResponse) verifyMfaRes()       {}
+
+type LoginUnauthorized ErrorResponse
+
+func (*LoginUnauthorized) loginRes() {}
 
 // LogoutOK is response for Logout operation.
 type LogoutOK struct{}
 
+// Ref: #/components/schemas/MfaChallenge
+type MfaChallenge struct {
+	// Single-use token for /auth/mfa/verify.
+	MfaToken  string    `json:"mfaToken"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// GetMfaToken returns the value of MfaToken.
+func (s *MfaChallenge) GetMfaToken() string {
+	return s.MfaToken
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *MfaChallenge) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// SetMfaToken sets the value of MfaToken.
+func (s *MfaChallenge) SetMfaToken(val string) {
+	s.MfaToken = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *MfaChallenge) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+func (*MfaChallenge) loginRes() {}
+
+// Ref: #/components/schemas/MfaEnrollment
+type MfaEnrollment struct {
+	// Base32 TOTP secret for manual entry.
+	Secret string `json:"secret"`
+	// Otpauth:// URI to render as a QR code.
+	OtpauthUri string `json:"otpauthUri"`
+}
+
+// GetSecret returns the value of Secret.
+func (s *MfaEnrollment) GetSecret() string {
+	return s.Secret
+}
+
+// GetOtpauthUri returns the value of OtpauthUri.
+func (s *MfaEnrollment) GetOtpauthUri() string {
+	return s.OtpauthUri
+}
+
+// SetSecret sets the value of Secret.
+func (s *MfaEnrollment) SetSecret(val string) {
+	s.Secret = val
+}
+
+// SetOtpauthUri sets the value of OtpauthUri.
+func (s *MfaEnrollment) SetOtpauthUri(val string) {
+	s.OtpauthUri = val
+}
+
+func (*MfaEnrollment) enrollMfaRes() {}
+
+// Ref: #/components/schemas/MfaRecoveryCodes
+type MfaRecoveryCodes struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// GetRecoveryCodes returns the value of RecoveryCodes.
+func (s *MfaRecoveryCodes) GetRecoveryCodes() []string {
+	return s.RecoveryCodes
+}
+
+// SetRecoveryCodes sets the value of RecoveryCodes.
+func (s *MfaRecoveryCodes) SetRecoveryCodes(val []string) {
+	s.RecoveryCodes = val
+}
+
+func (*MfaRecoveryCodes) confirmMfaRes() {}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptDateTime returns new OptDateTime with value set to v.
 func NewOptDateTime(v time.Time) OptDateTime {
 	return OptDateTime{
@@ -929,6 +2267,52 @@ func (o OptFloat64) Or(d float64) float64 {
 	return d
 }
 
+// NewOptImpersonator returns new OptImpersonator with value set to v.
+func NewOptImpersonator(v Impersonator) OptImpersonator {
+	return OptImpersonator{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptImpersonator is optional Impersonator.
+type OptImpersonator struct {
+	Value Impersonator
+	Set   bool
+}
+
+// IsSet returns true if OptImpersonator was set.
+func (o OptImpersonator) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptImpersonator) Reset() {
+	var v Impersonator
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptImpersonator) SetTo(v Impersonator) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptImpersonator) Get() (v Impersonator, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptImpersonator) Or(d Impersonator) Impersonator {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptInt returns new OptInt with value set to v.
 func NewOptInt(v int) OptInt {
 	return OptInt{
@@ -1067,6 +2451,98 @@ func (o OptListAppsType) Or(d ListAppsType) ListAppsType {
 	return d
 }
 
+// NewOptLoginOutcome returns new OptLoginOutcome with value set to v.
+func NewOptLoginOutcome(v LoginOutcome) OptLoginOutcome {
+	return OptLoginOutcome{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLoginOutcome is optional LoginOutcome.
+type OptLoginOutcome struct {
+	Value LoginOutcome
+	Set   bool
+}
+
+// IsSet returns true if OptLoginOutcome was set.
+func (o OptLoginOutcome) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLoginOutcome) Reset() {
+	var v LoginOutcome
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLoginOutcome) SetTo(v LoginOutcome) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLoginOutcome) Get() (v LoginOutcome, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLoginOutcome) Or(d LoginOutcome) LoginOutcome {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPageCursors returns new OptPageCursors with value set to v.
+func NewOptPageCursors(v PageCursors) OptPageCursors {
+	return OptPageCursors{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPageCursors is optional PageCursors.
+type OptPageCursors struct {
+	Value PageCursors
+	Set   bool
+}
+
+// IsSet returns true if OptPageCursors was set.
+func (o OptPageCursors) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPageCursors) Reset() {
+	var v PageCursors
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPageCursors) SetTo(v PageCursors) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPageCursors) Get() (v PageCursors, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPageCursors) Or(d PageCursors) PageCursors {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptString returns new OptString with value set to v.
 func NewOptString(v string) OptString {
 	return OptString{
@@ -1251,6 +2727,52 @@ func (o OptTaskStatus) Or(d TaskStatus) TaskStatus {
 	return d
 }
 
+// NewOptUserExportFormat returns new OptUserExportFormat with value set to v.
+func NewOptUserExportFormat(v UserExportFormat) OptUserExportFormat {
+	return OptUserExportFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUserExportFormat is optional UserExportFormat.
+type OptUserExportFormat struct {
+	Value UserExportFormat
+	Set   bool
+}
+
+// IsSet returns true if OptUserExportFormat was set.
+func (o OptUserExportFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUserExportFormat) Reset() {
+	var v UserExportFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUserExportFormat) SetTo(v UserExportFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUserExportFormat) Get() (v UserExportFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUserExportFormat) Or(d UserExportFormat) UserExportFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptUserRole returns new OptUserRole with value set to v.
 func NewOptUserRole(v UserRole) OptUserRole {
 	return OptUserRole{
@@ -1343,6 +2865,34 @@ func (o OptUserStatus) Or(d UserStatus) UserStatus {
 	return d
 }
 
+// Opaque cursors for the neighbouring pages, each set only where that page exists. Pass one as the
+// cursor parameter to fetch the page.
+// Ref: #/components/schemas/PageCursors
+type PageCursors struct {
+	Next OptString `json:"next"`
+	Prev OptString `json:"prev"`
+}
+
+// GetNext returns the value of Next.
+func (s *PageCursors) GetNext() OptString {
+	return s.Next
+}
+
+// GetPrev returns the value of Prev.
+func (s *PageCursors) GetPrev() OptString {
+	return s.Prev
+}
+
+// SetNext sets the value of Next.
+func (s *PageCursors) SetNext(val OptString) {
+	s.Next = val
+}
+
+// SetPrev sets the value of Prev.
+func (s *PageCursors) SetPrev(val OptString) {
+	s.Prev = val
+}
+
 // Ref: #/components/schemas/PaginationMeta
 type PaginationMeta struct {
 	Page       int `json:"page"`
@@ -1391,6 +2941,16 @@ func (s *PaginationMeta) SetTotalPages(val int) {
 	s.TotalPages = val
 }
 
+// PurgeUserNoContent is response for PurgeUser operation.
+type PurgeUserNoContent struct{}
+
+func (*PurgeUserNoContent) purgeUserRes() {}
+
+// PurgeUserNotFound is response for PurgeUser operation.
+type PurgeUserNotFound struct{}
+
+func (*PurgeUserNotFound) purgeUserRes() {}
+
 // Ref: #/components/schemas/RecentSale
 type RecentSale struct {
 	Name   string    `json:"name"`
@@ -1465,6 +3025,118 @@ func (s *RecentSalesResponse) SetTotalSales(val int) {
 	s.TotalSales = val
 }
 
+// Ref: #/components/schemas/RefreshTokenRequest
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *RefreshTokenRequest) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *RefreshTokenRequest) SetRefreshToken(val string) {
+	s.RefreshToken = val
+}
+
+// ResendInvitationNoContent is response for ResendInvitation operation.
+type ResendInvitationNoContent struct{}
+
+func (*ResendInvitationNoContent) resendInvitationRes() {}
+
+// ResendInvitationNotFound is response for ResendInvitation operation.
+type ResendInvitationNotFound struct{}
+
+func (*ResendInvitationNotFound) resendInvitationRes() {}
+
+// ResetPasswordNoContent is response for ResetPassword operation.
+type ResetPasswordNoContent struct{}
+
+func (*ResetPasswordNoContent) resetPasswordRes() {}
+
+// Ref: #/components/schemas/ResetPasswordRequest
+type ResetPasswordRequest struct {
+	Token string `json:"token"`
+	// Must satisfy the password policy.
+	Password string `json:"password"`
+}
+
+// GetToken returns the value of Token.
+func (s *ResetPasswordRequest) GetToken() string {
+	return s.Token
+}
+
+// GetPassword returns the value of Password.
+func (s *ResetPasswordRequest) GetPassword() string {
+	return s.Password
+}
+
+// SetToken sets the value of Token.
+func (s *ResetPasswordRequest) SetToken(val string) {
+	s.Token = val
+}
+
+// SetPassword sets the value of Password.
+func (s *ResetPasswordRequest) SetPassword(val string) {
+	s.Password = val
+}
+
+// ResetUserMfaNoContent is response for ResetUserMfa operation.
+type ResetUserMfaNoContent struct{}
+
+func (*ResetUserMfaNoContent) resetUserMfaRes() {}
+
+// ResetUserMfaNotFound is response for ResetUserMfa operation.
+type ResetUserMfaNotFound struct{}
+
+func (*ResetUserMfaNotFound) resetUserMfaRes() {}
+
+// RestoreUserNotFound is response for RestoreUser operation.
+type RestoreUserNotFound struct{}
+
+func (*RestoreUserNotFound) restoreUserRes() {}
+
+// RevokeApiKeyNoContent is response for RevokeApiKey operation.
+type RevokeApiKeyNoContent struct{}
+
+func (*RevokeApiKeyNoContent) revokeApiKeyRes() {}
+
+// RevokeApiKeyNotFound is response for RevokeApiKey operation.
+type RevokeApiKeyNotFound struct{}
+
+func (*RevokeApiKeyNotFound) revokeApiKeyRes() {}
+
+// RevokeInvitationNoContent is response for RevokeInvitation operation.
+type RevokeInvitationNoContent struct{}
+
+func (*RevokeInvitationNoContent) revokeInvitationRes() {}
+
+// RevokeInvitationNotFound is response for RevokeInvitation operation.
+type RevokeInvitationNotFound struct{}
+
+func (*RevokeInvitationNotFound) revokeInvitationRes() {}
+
+// RevokeUserSessionNoContent is response for RevokeUserSession operation.
+type RevokeUserSessionNoContent struct{}
+
+func (*RevokeUserSessionNoContent) revokeUserSessionRes() {}
+
+// RevokeUserSessionNotFound is response for RevokeUserSession operation.
+type RevokeUserSessionNotFound struct{}
+
+func (*RevokeUserSessionNotFound) revokeUserSessionRes() {}
+
+// RevokeUserSessionsNoContent is response for RevokeUserSessions operation.
+type RevokeUserSessionsNoContent struct{}
+
+func (*RevokeUserSessionsNoContent) revokeUserSessionsRes() {}
+
+// RevokeUserSessionsNotFound is response for RevokeUserSessions operation.
+type RevokeUserSessionsNotFound struct{}
+
+func (*RevokeUserSessionsNotFound) revokeUserSessionsRes() {}
+
 // Ref: #/components/schemas/SendMessageRequest
 type SendMessageRequest struct {
 	Message string `json:"message"`
@@ -1480,10 +3152,149 @@ func (s *SendMessageRequest) SetMessage(val string) {
 	s.Message = val
 }
 
+// Ref: #/components/schemas/Session
+type Session struct {
+	// Token ID of the session.
+	ID        string    `json:"id"`
+	UserId    uuid.UUID `json:"userId"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// GetID returns the value of ID.
+func (s *Session) GetID() string {
+	return s.ID
+}
+
+// GetUserId returns the value of UserId.
+func (s *Session) GetUserId() uuid.UUID {
+	return s.UserId
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Session) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *Session) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// SetID sets the value of ID.
+func (s *Session) SetID(val string) {
+	s.ID = val
+}
+
+// SetUserId sets the value of UserId.
+func (s *Session) SetUserId(val uuid.UUID) {
+	s.UserId = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Session) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *Session) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+// Ref: #/components/schemas/SessionListResponse
+type SessionListResponse struct {
+	Data []Session `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *SessionListResponse) GetData() []Session {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *SessionListResponse) SetData(val []Session) {
+	s.Data = val
+}
+
+func (*SessionListResponse) listUserSessionsRes() {}
+
+// Ref: #/components/schemas/SsoAuthorization
+type SsoAuthorization struct {
+	AuthorizationUrl url.URL `json:"authorizationUrl"`
+	// Opaque value the identity provider returns with the code.
+	State     string    `json:"state"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// GetAuthorizationUrl returns the value of AuthorizationUrl.
+func (s *SsoAuthorization) GetAuthorizationUrl() url.URL {
+	return s.AuthorizationUrl
+}
+
+// GetState returns the value of State.
+func (s *SsoAuthorization) GetState() string {
+	return s.State
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *SsoAuthorization) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// SetAuthorizationUrl sets the value of AuthorizationUrl.
+func (s *SsoAuthorization) SetAuthorizationUrl(val url.URL) {
+	s.AuthorizationUrl = val
+}
+
+// SetState sets the value of State.
+func (s *SsoAuthorization) SetState(val string) {
+	s.State = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *SsoAuthorization) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+func (*SsoAuthorization) startSsoRes() {}
+
+// Ref: #/components/schemas/SsoCallbackRequest
+type SsoCallbackRequest struct {
+	Code  string `json:"code"`
+	State string `json:"state"`
+}
+
+// GetCode returns the value of Code.
+func (s *SsoCallbackRequest) GetCode() string {
+	return s.Code
+}
+
+// GetState returns the value of State.
+func (s *SsoCallbackRequest) GetState() string {
+	return s.State
+}
+
+// SetCode sets the value of Code.
+func (s *SsoCallbackRequest) SetCode(val string) {
+	s.Code = val
+}
+
+// SetState sets the value of State.
+func (s *SsoCallbackRequest) SetState(val string) {
+	s.State = val
+}
+
+// StopImpersonationNoContent is response for StopImpersonation operation.
+type StopImpersonationNoContent struct{}
+
+func (*StopImpersonationNoContent) stopImpersonationRes() {}
+
 // Ref: #/components/schemas/Task
 type Task struct {
 	// Task ID in format TASK-XXXX.
-	ID          string       `json:"id"`
+	ID string `json:"id"`
+	// Incremented on every update; also returned as the ETag.
+	Version     int          `json:"version"`
 	Title       string       `json:"title"`
 	Status      TaskStatus   `json:"status"`
 	Label       TaskLabel    `json:"label"`
@@ -1500,6 +3311,11 @@ func (s *Task) GetID() string {
 	return s.ID
 }
 
+// GetVersion returns the value of Version.
+func (s *Task) GetVersion() int {
+	return s.Version
+}
+
 // GetTitle returns the value of Title.
 func (s *Task) GetTitle() string {
 	return s.Title
@@ -1550,6 +3366,11 @@ func (s *Task) SetID(val string) {
 	s.ID = val
 }
 
+// SetVersion sets the value of Version.
+func (s *Task) SetVersion(val int) {
+	s.Version = val
+}
+
 // SetTitle sets the value of Title.
 func (s *Task) SetTitle(val string) {
 	s.Title = val
@@ -1595,8 +3416,34 @@ func (s *Task) SetDueDate(val OptDateTime) {
 	s.DueDate = val
 }
 
-func (*Task) getTaskRes()    {}
-func (*Task) updateTaskRes() {}
+// TaskHeaders wraps Task with response headers.
+type TaskHeaders struct {
+	ETag     OptString
+	Response Task
+}
+
+// GetETag returns the value of ETag.
+func (s *TaskHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *TaskHeaders) GetResponse() Task {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *TaskHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *TaskHeaders) SetResponse(val Task) {
+	s.Response = val
+}
+
+func (*TaskHeaders) getTaskRes()    {}
+func (*TaskHeaders) updateTaskRes() {}
 
 // Ref: #/components/schemas/TaskLabel
 type TaskLabel string
@@ -1649,8 +3496,9 @@ func (s *TaskLabel) UnmarshalText(data []byte) error {
 
 // Ref: #/components/schemas/TaskListResponse
 type TaskListResponse struct {
-	Data []Task         `json:"data"`
-	Meta PaginationMeta `json:"meta"`
+	Data    []Task         `json:"data"`
+	Meta    PaginationMeta `json:"meta"`
+	Cursors OptPageCursors `json:"cursors"`
 }
 
 // GetData returns the value of Data.
@@ -1663,6 +3511,11 @@ func (s *TaskListResponse) GetMeta() PaginationMeta {
 	return s.Meta
 }
 
+// GetCursors returns the value of Cursors.
+func (s *TaskListResponse) GetCursors() OptPageCursors {
+	return s.Cursors
+}
+
 // SetData sets the value of Data.
 func (s *TaskListResponse) SetData(val []Task) {
 	s.Data = val
@@ -1673,6 +3526,11 @@ func (s *TaskListResponse) SetMeta(val PaginationMeta) {
 	s.Meta = val
 }
 
+// SetCursors sets the value of Cursors.
+func (s *TaskListResponse) SetCursors(val OptPageCursors) {
+	s.Cursors = val
+}
+
 // Ref: #/components/schemas/TaskPriority
 type TaskPriority string
 
@@ -1785,6 +3643,16 @@ func (s *TaskStatus) UnmarshalText(data []byte) error {
 	}
 }
 
+// UnlockUserNoContent is response for UnlockUser operation.
+type UnlockUserNoContent struct{}
+
+func (*UnlockUserNoContent) unlockUserRes() {}
+
+// UnlockUserNotFound is response for UnlockUser operation.
+type UnlockUserNotFound struct{}
+
+func (*UnlockUserNotFound) unlockUserRes() {}
+
 // UpdateTaskNotFound is response for UpdateTask operation.
 type UpdateTaskNotFound struct{}
 
@@ -1958,6 +3826,10 @@ type User struct {
 	Role        UserRole    `json:"role"`
 	CreatedAt   OptDateTime `json:"createdAt"`
 	UpdatedAt   OptDateTime `json:"updatedAt"`
+	// Set when the user has been deleted.
+	DeletedAt OptDateTime `json:"deletedAt"`
+	// Incremented on every update; also returned as the ETag.
+	Version int `json:"version"`
 }
 
 // GetID returns the value of ID.
@@ -2010,6 +3882,16 @@ func (s *User) GetUpdatedAt() OptDateTime {
 	return s.UpdatedAt
 }
 
+// GetDeletedAt returns the value of DeletedAt.
+func (s *User) GetDeletedAt() OptDateTime {
+	return s.DeletedAt
+}
+
+// GetVersion returns the value of Version.
+func (s *User) GetVersion() int {
+	return s.Version
+}
+
 // SetID sets the value of ID.
 func (s *User) SetID(val uuid.UUID) {
 	s.ID = val
@@ -2060,13 +3942,207 @@ func (s *User) SetUpdatedAt(val OptDateTime) {
 	s.UpdatedAt = val
 }
 
-func (*User) getUserRes()    {}
-func (*User) updateUserRes() {}
+// SetDeletedAt sets the value of DeletedAt.
+func (s *User) SetDeletedAt(val OptDateTime) {
+	s.DeletedAt = val
+}
+
+// SetVersion sets the value of Version.
+func (s *User) SetVersion(val int) {
+	s.Version = val
+}
+
+func (*User) acceptInvitationRes() {}
+
+// Ref: #/components/schemas/UserExportFormat
+type UserExportFormat string
+
+const (
+	UserExportFormatCsv  UserExportFormat = "csv"
+	UserExportFormatXlsx UserExportFormat = "xlsx"
+)
+
+// AllValues returns all UserExportFormat values.
+func (UserExportFormat) AllValues() []UserExportFormat {
+	return []UserExportFormat{
+		UserExportFormatCsv,
+		UserExportFormatXlsx,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s UserExportFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case UserExportFormatCsv:
+		return []byte(s), nil
+	case UserExportFormatXlsx:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UserExportFormat) UnmarshalText(data []byte) error {
+	switch UserExportFormat(data) {
+	case UserExportFormatCsv:
+		*s = UserExportFormatCsv
+		return nil
+	case UserExportFormatXlsx:
+		*s = UserExportFormatXlsx
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// UserHeaders wraps User with response headers.
+type UserHeaders struct {
+	ETag     OptString
+	Response User
+}
+
+// GetETag returns the value of ETag.
+func (s *UserHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *UserHeaders) GetResponse() User {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *UserHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *UserHeaders) SetResponse(val User) {
+	s.Response = val
+}
+
+func (*UserHeaders) getUserRes()     {}
+func (*UserHeaders) restoreUserRes() {}
+func (*UserHeaders) updateUserRes()  {}
+
+// Ref: #/components/schemas/UserImportError
+type UserImportError struct {
+	// Line number in the file, counting the header as line 1.
+	Row     int       `json:"row"`
+	Field   OptString `json:"field"`
+	Message string    `json:"message"`
+}
+
+// GetRow returns the value of Row.
+func (s *UserImportError) GetRow() int {
+	return s.Row
+}
+
+// GetField returns the value of Field.
+func (s *UserImportError) GetField() OptString {
+	return s.Field
+}
+
+// GetMessage returns the value of Message.
+func (s *UserImportError) GetMessage() string {
+	return s.Message
+}
+
+// SetRow sets the value of Row.
+func (s *UserImportError) SetRow(val int) {
+	s.Row = val
+}
+
+// SetField sets the value of Field.
+func (s *UserImportError) SetField(val OptString) {
+	s.Field = val
+}
+
+// SetMessage sets the value of Message.
+func (s *UserImportError) SetMessage(val string) {
+	s.Message = val
+}
+
+// Ref: #/components/schemas/UserImportReport
+type UserImportReport struct {
+	DryRun bool `json:"dryRun"`
+	// Number of data rows in the file.
+	Total   int               `json:"total"`
+	Valid   int               `json:"valid"`
+	Created int               `json:"created"`
+	Errors  []UserImportError `json:"errors"`
+	// The users created by the import.
+	Users []User `json:"users"`
+}
+
+// GetDryRun returns the value of DryRun.
+func (s *UserImportReport) GetDryRun() bool {
+	return s.DryRun
+}
+
+// GetTotal returns the value of Total.
+func (s *UserImportReport) GetTotal() int {
+	return s.Total
+}
+
+// GetValid returns the value of Valid.
+func (s *UserImportReport) GetValid() int {
+	return s.Valid
+}
+
+// GetCreated returns the value of Created.
+func (s *UserImportReport) GetCreated() int {
+	return s.Created
+}
+
+// GetErrors returns the value of Errors.
+func (s *UserImportReport) GetErrors() []UserImportError {
+	return s.Errors
+}
+
+// GetUsers returns the value of Users.
+func (s *UserImportReport) GetUsers() []User {
+	return s.Users
+}
+
+// SetDryRun sets the value of DryRun.
+func (s *UserImportReport) SetDryRun(val bool) {
+	s.DryRun = val
+}
+
+// SetTotal sets the value of Total.
+func (s *UserImportReport) SetTotal(val int) {
+	s.Total = val
+}
+
+// SetValid sets the value of Valid.
+func (s *UserImportReport) SetValid(val int) {
+	s.Valid = val
+}
+
+// SetCreated sets the value of Created.
+func (s *UserImportReport) SetCreated(val int) {
+	s.Created = val
+}
+
+// SetErrors sets the value of Errors.
+func (s *UserImportReport) SetErrors(val []UserImportError) {
+	s.Errors = val
+}
+
+// SetUsers sets the value of Users.
+func (s *UserImportReport) SetUsers(val []User) {
+	s.Users = val
+}
+
+func (*UserImportReport) importUsersRes() {}
 
 // Ref: #/components/schemas/UserListResponse
 type UserListResponse struct {
-	Data []User         `json:"data"`
-	Meta PaginationMeta `json:"meta"`
+	Data    []User         `json:"data"`
+	Meta    PaginationMeta `json:"meta"`
+	Cursors OptPageCursors `json:"cursors"`
 }
 
 // GetData returns the value of Data.
@@ -2079,6 +4155,11 @@ func (s *UserListResponse) GetMeta() PaginationMeta {
 	return s.Meta
 }
 
+// GetCursors returns the value of Cursors.
+func (s *UserListResponse) GetCursors() OptPageCursors {
+	return s.Cursors
+}
+
 // SetData sets the value of Data.
 func (s *UserListResponse) SetData(val []User) {
 	s.Data = val
@@ -2089,6 +4170,11 @@ func (s *UserListResponse) SetMeta(val PaginationMeta) {
 	s.Meta = val
 }
 
+// SetCursors sets the value of Cursors.
+func (s *UserListResponse) SetCursors(val OptPageCursors) {
+	s.Cursors = val
+}
+
 // Ref: #/components/schemas/UserRole
 type UserRole string
 
@@ -2200,3 +4286,42 @@ func (s *UserStatus) UnmarshalText(data []byte) error {
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
+
+// Ref: #/components/schemas/VerifyMfaRequest
+type VerifyMfaRequest struct {
+	MfaToken string `json:"mfaToken"`
+	// Current code from the authenticator app.
+	Code OptString `json:"code"`
+	// Unused recovery code, when the authenticator is unavailable.
+	RecoveryCode OptString `json:"recoveryCode"`
+}
+
+// GetMfaToken returns the value of MfaToken.
+func (s *VerifyMfaRequest) GetMfaToken() string {
+	return s.MfaToken
+}
+
+// GetCode returns the value of Code.
+func (s *VerifyMfaRequest) GetCode() OptString {
+	return s.Code
+}
+
+// GetRecoveryCode returns the value of RecoveryCode.
+func (s *VerifyMfaRequest) GetRecoveryCode() OptString {
+	return s.RecoveryCode
+}
+
+// SetMfaToken sets the value of MfaToken.
+func (s *VerifyMfaRequest) SetMfaToken(val string) {
+	s.MfaToken = val
+}
+
+// SetCode sets the value of Code.
+func (s *VerifyMfaRequest) SetCode(val OptString) {
+	s.Code = val
+}
+
+// SetRecoveryCode sets the value of RecoveryCode.
+func (s *VerifyMfaRequest) SetRecoveryCode(val OptString) {
+	s.RecoveryCode = val
+}
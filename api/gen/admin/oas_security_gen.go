@@ -0,0 +1,113 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/ogen-go/ogen/ogenerrors"
+)
+
+// SecurityHandler is handler for security parameters.
+type SecurityHandler interface {
+	// HandleBearerAuth handles BearerAuth security.
+	// Signed access token returned by login, or a personal API key.
+	HandleBearerAuth(ctx context.Context, operationName OperationName, t BearerAuth) (context.Context, error)
+}
+
+func findAuthorization(h http.Header, prefix string) (string, bool) {
+	v, ok := h["Authorization"]
+	if !ok {
+		return "", false
+	}
+	for _, vv := range v {
+		scheme, value, ok := strings.Cut(vv, " ")
+		if !ok || !strings.EqualFold(scheme, prefix) {
+			continue
+		}
+		return value, true
+	}
+	return "", false
+}
+
+var operationRolesBearerAuth = map[string][]string{
+	BulkUserActionOperation:       []string{},
+	ChangePasswordOperation:       []string{},
+	ConfirmMfaOperation:           []string{},
+	ConnectAppOperation:           []string{},
+	CreateApiKeyOperation:         []string{},
+	CreateTaskOperation:           []string{},
+	CreateUserOperation:           []string{},
+	DeleteTaskOperation:           []string{},
+	DeleteUserOperation:           []string{},
+	DisconnectAppOperation:        []string{},
+	EnrollMfaOperation:            []string{},
+	ExportUsersOperation:          []string{},
+	GetChatOperation:              []string{},
+	GetCurrentUserOperation:       []string{},
+	GetDashboardOverviewOperation: []string{},
+	GetDashboardStatsOperation:    []string{},
+	GetRecentSalesOperation:       []string{},
+	GetTaskOperation:              []string{},
+	GetUserOperation:              []string{},
+	ImpersonateUserOperation:      []string{},
+	ImportUsersOperation:          []string{},
+	InviteUserOperation:           []string{},
+	ListApiKeysOperation:          []string{},
+	ListAppsOperation:             []string{},
+	ListChatsOperation:            []string{},
+	ListLoginHistoryOperation:     []string{},
+	ListTasksOperation:            []string{},
+	ListUserSessionsOperation:     []string{},
+	ListUsersOperation:            []string{},
+	LogoutOperation:               []string{},
+	PurgeUserOperation:            []string{},
+	ResendInvitationOperation:     []string{},
+	ResetUserMfaOperation:         []string{},
+	RestoreUserOperation:          []string{},
+	RevokeApiKeyOperation:         []string{},
+	RevokeInvitationOperation:     []string{},
+	RevokeUserSessionOperation:    []string{},
+	RevokeUserSessionsOperation:   []string{},
+	SendMessageOperation:          []string{},
+	StopImpersonationOperation:    []string{},
+	UnlockUserOperation:           []string{},
+	UpdateTaskOperation:           []string{},
+	UpdateUserOperation:           []string{},
+}
+
+func (s *Server) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t BearerAuth
+	token, ok := findAuthorization(req.Header, "Bearer")
+	if !ok {
+		return ctx, false, nil
+	}
+	t.Token = token
+	t.Roles = operationRolesBearerAuth[operationName]
+	rctx, err := s.sec.HandleBearerAuth(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+// SecuritySource is provider of security values (tokens, passwords, etc.).
+type SecuritySource interface {
+	// BearerAuth provides BearerAuth security value.
+	// Signed access token returned by login, or a personal API key.
+	BearerAuth(ctx context.Context, operationName OperationName) (BearerAuth, error)
+}
+
+func (s *Client) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.BearerAuth(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"BearerAuth\"")
+	}
+	req.Header.Set("Authorization", "Bearer "+t.Token)
+	return nil
+}
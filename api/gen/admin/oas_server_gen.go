@@ -8,12 +8,51 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// AcceptInvitation implements acceptInvitation operation.
+	//
+	// Accept an invitation and activate the account.
+	//
+	// POST /auth/invitations/{token}/accept
+	AcceptInvitation(ctx context.Context, req *AcceptInvitationRequest, params AcceptInvitationParams) (AcceptInvitationRes, error)
+	// BulkUserAction implements bulkUserAction operation.
+	//
+	// The action is applied to every eligible user in a single transaction, and the result for each ID
+	// is reported. Superadmins can only be changed by superadmins, and callers cannot change themselves.
+	// The request is refused when it would leave no active superadmin.
+	//
+	// POST /users/bulk
+	BulkUserAction(ctx context.Context, req *BulkUserActionRequest) (BulkUserActionRes, error)
+	// ChangePassword implements changePassword operation.
+	//
+	// Change the current user's password.
+	//
+	// POST /auth/password/change
+	ChangePassword(ctx context.Context, req *ChangePasswordRequest) (ChangePasswordRes, error)
+	// CompleteSso implements completeSso operation.
+	//
+	// Complete single sign-on with the code returned by the identity provider.
+	//
+	// POST /auth/sso/callback
+	CompleteSso(ctx context.Context, req *SsoCallbackRequest) (CompleteSsoRes, error)
+	// ConfirmMfa implements confirmMfa operation.
+	//
+	// Enable MFA by confirming the first code from the authenticator.
+	//
+	// POST /auth/mfa/confirm
+	ConfirmMfa(ctx context.Context, req *ConfirmMfaRequest) (ConfirmMfaRes, error)
 	// ConnectApp implements connectApp operation.
 	//
 	// Connect an app integration.
 	//
 	// POST /apps/{appId}/connect
 	ConnectApp(ctx context.Context, params ConnectAppParams) (*App, error)
+	// CreateApiKey implements createApiKey operation.
+	//
+	// The key acts with the current user's role, limited to its scopes. The secret is only returned in
+	// this response; send it as the bearer token.
+	//
+	// POST /auth/api-keys
+	CreateApiKey(ctx context.Context, req *CreateApiKeyRequest) (CreateApiKeyRes, error)
 	// CreateTask implements createTask operation.
 	//
 	// Create a new task.
@@ -34,7 +73,8 @@ type Handler interface {
 	DeleteTask(ctx context.Context, params DeleteTaskParams) (DeleteTaskRes, error)
 	// DeleteUser implements deleteUser operation.
 	//
-	// Delete a user.
+	// Deleted users are hidden from lists and can no longer sign in, but keep their history and can be
+	// restored. Their sessions are revoked.
 	//
 	// DELETE /users/{userId}
 	DeleteUser(ctx context.Context, params DeleteUserParams) (DeleteUserRes, error)
@@ -44,6 +84,25 @@ type Handler interface {
 	//
 	// POST /apps/{appId}/disconnect
 	DisconnectApp(ctx context.Context, params DisconnectAppParams) (*App, error)
+	// EnrollMfa implements enrollMfa operation.
+	//
+	// Replaces any unconfirmed enrolment. MFA stays off until confirmed.
+	//
+	// POST /auth/mfa/enroll
+	EnrollMfa(ctx context.Context) (EnrollMfaRes, error)
+	// ExportUsers implements exportUsers operation.
+	//
+	// Streams every user matching the filters, newest first. Takes the same filters as listUsers.
+	// Password hashes are never exported.
+	//
+	// GET /users/export
+	ExportUsers(ctx context.Context, params ExportUsersParams) (ExportUsersRes, error)
+	// ForgotPassword implements forgotPassword operation.
+	//
+	// Always succeeds so that registered emails cannot be discovered.
+	//
+	// POST /auth/password/forgot
+	ForgotPassword(ctx context.Context, req *ForgotPasswordRequest) error
 	// GetChat implements getChat operation.
 	//
 	// Get a chat conversation by ID.
@@ -68,6 +127,12 @@ type Handler interface {
 	//
 	// GET /dashboard/stats
 	GetDashboardStats(ctx context.Context) (*DashboardStats, error)
+	// GetInvitation implements getInvitation operation.
+	//
+	// Look up a pending invitation.
+	//
+	// GET /auth/invitations/{token}
+	GetInvitation(ctx context.Context, params GetInvitationParams) (GetInvitationRes, error)
 	// GetRecentSales implements getRecentSales operation.
 	//
 	// Get recent sales data.
@@ -86,12 +151,34 @@ type Handler interface {
 	//
 	// GET /users/{userId}
 	GetUser(ctx context.Context, params GetUserParams) (GetUserRes, error)
+	// ImpersonateUser implements impersonateUser operation.
+	//
+	// For support staff to see exactly what the user sees. The token cannot be refreshed, cannot change
+	// passwords, MFA, API keys or roles, and the start and stop of every impersonation are recorded.
+	//
+	// POST /users/{userId}/impersonate
+	ImpersonateUser(ctx context.Context, req *ImpersonateRequest, params ImpersonateUserParams) (ImpersonateUserRes, error)
+	// ImportUsers implements importUsers operation.
+	//
+	// The CSV header names the columns: email and role are required; firstName, lastName, username,
+	// phoneNumber, status and password are optional. Usernames are generated as for createUser when not
+	// given. Every row is validated first. Unless dryRun is set, the valid rows are then created in a
+	// single transaction and the invalid ones are reported and skipped.
+	//
+	// POST /users/import
+	ImportUsers(ctx context.Context, req *ImportUsersReq) (ImportUsersRes, error)
 	// InviteUser implements inviteUser operation.
 	//
 	// Invite a new user.
 	//
 	// POST /users/invite
 	InviteUser(ctx context.Context, req *InviteUserRequest) (*User, error)
+	// ListApiKeys implements listApiKeys operation.
+	//
+	// List the current user's API keys.
+	//
+	// GET /auth/api-keys
+	ListApiKeys(ctx context.Context) (*ApiKeyListResponse, error)
 	// ListApps implements listApps operation.
 	//
 	// List all app integrations.
@@ -104,12 +191,24 @@ type Handler interface {
 	//
 	// GET /chats
 	ListChats(ctx context.Context, params ListChatsParams) (*ChatListResponse, error)
+	// ListLoginHistory implements listLoginHistory operation.
+	//
+	// List a user's login attempts, newest first.
+	//
+	// GET /users/{userId}/login-history
+	ListLoginHistory(ctx context.Context, params ListLoginHistoryParams) (ListLoginHistoryRes, error)
 	// ListTasks implements listTasks operation.
 	//
 	// List all tasks.
 	//
 	// GET /tasks
 	ListTasks(ctx context.Context, params ListTasksParams) (*TaskListResponse, error)
+	// ListUserSessions implements listUserSessions operation.
+	//
+	// List a user's active sessions.
+	//
+	// GET /users/{userId}/sessions
+	ListUserSessions(ctx context.Context, params ListUserSessionsParams) (ListUserSessionsRes, error)
 	// ListUsers implements listUsers operation.
 	//
 	// List all users.
@@ -128,12 +227,94 @@ type Handler interface {
 	//
 	// POST /auth/logout
 	Logout(ctx context.Context) error
+	// PurgeUser implements purgeUser operation.
+	//
+	// Removes the user and their credentials for good, freeing the email and username for reuse. Only
+	// deleted users can be purged.
+	//
+	// DELETE /users/{userId}/purge
+	PurgeUser(ctx context.Context, params PurgeUserParams) (PurgeUserRes, error)
+	// RefreshToken implements refreshToken operation.
+	//
+	// Refresh tokens are single use. Each call rotates the refresh token;
+	// presenting an already used refresh token revokes the whole session.
+	//
+	// POST /auth/refresh
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest) (RefreshTokenRes, error)
+	// ResendInvitation implements resendInvitation operation.
+	//
+	// Send a new invitation link, invalidating previous ones.
+	//
+	// POST /users/{userId}/invitation/resend
+	ResendInvitation(ctx context.Context, params ResendInvitationParams) (ResendInvitationRes, error)
+	// ResetPassword implements resetPassword operation.
+	//
+	// Set a new password using a reset token.
+	//
+	// POST /auth/password/reset
+	ResetPassword(ctx context.Context, req *ResetPasswordRequest) (ResetPasswordRes, error)
+	// ResetUserMfa implements resetUserMfa operation.
+	//
+	// Turn off a user's MFA so they can enrol again.
+	//
+	// DELETE /users/{userId}/mfa
+	ResetUserMfa(ctx context.Context, params ResetUserMfaParams) (ResetUserMfaRes, error)
+	// RestoreUser implements restoreUser operation.
+	//
+	// Restore a deleted user.
+	//
+	// POST /users/{userId}/restore
+	RestoreUser(ctx context.Context, params RestoreUserParams) (RestoreUserRes, error)
+	// RevokeApiKey implements revokeApiKey operation.
+	//
+	// Revoke one of the current user's API keys.
+	//
+	// DELETE /auth/api-keys/{keyId}
+	RevokeApiKey(ctx context.Context, params RevokeApiKeyParams) (RevokeApiKeyRes, error)
+	// RevokeInvitation implements revokeInvitation operation.
+	//
+	// Revoke a pending invitation and remove the invited user.
+	//
+	// DELETE /users/{userId}/invitation
+	RevokeInvitation(ctx context.Context, params RevokeInvitationParams) (RevokeInvitationRes, error)
+	// RevokeUserSession implements revokeUserSession operation.
+	//
+	// Revoke a single session.
+	//
+	// DELETE /users/{userId}/sessions/{sessionId}
+	RevokeUserSession(ctx context.Context, params RevokeUserSessionParams) (RevokeUserSessionRes, error)
+	// RevokeUserSessions implements revokeUserSessions operation.
+	//
+	// Revoke all of a user's sessions.
+	//
+	// DELETE /users/{userId}/sessions
+	RevokeUserSessions(ctx context.Context, params RevokeUserSessionsParams) (RevokeUserSessionsRes, error)
 	// SendMessage implements sendMessage operation.
 	//
 	// Send a message in a chat.
 	//
 	// POST /chats/{chatId}/messages
 	SendMessage(ctx context.Context, req *SendMessageRequest, params SendMessageParams) (*ChatMessage, error)
+	// StartSso implements startSso operation.
+	//
+	// Returns the identity provider URL to redirect the browser to. The provider redirects back to the
+	// configured callback with a code and the returned state, which are then posted to
+	// /auth/sso/callback.
+	//
+	// POST /auth/sso/start
+	StartSso(ctx context.Context) (StartSsoRes, error)
+	// StopImpersonation implements stopImpersonation operation.
+	//
+	// End the current impersonation and revoke its token.
+	//
+	// POST /auth/impersonation/stop
+	StopImpersonation(ctx context.Context) (StopImpersonationRes, error)
+	// UnlockUser implements unlockUser operation.
+	//
+	// Clear failed login attempts and lift a lockout.
+	//
+	// POST /users/{userId}/unlock
+	UnlockUser(ctx context.Context, params UnlockUserParams) (UnlockUserRes, error)
 	// UpdateTask implements updateTask operation.
 	//
 	// Update a task.
@@ -146,23 +327,31 @@ type Handler interface {
 	//
 	// PUT /users/{userId}
 	UpdateUser(ctx context.Context, req *UpdateUserRequest, params UpdateUserParams) (UpdateUserRes, error)
+	// VerifyMfa implements verifyMfa operation.
+	//
+	// Complete a login with a TOTP or recovery code.
+	//
+	// POST /auth/mfa/verify
+	VerifyMfa(ctx context.Context, req *VerifyMfaRequest) (VerifyMfaRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
 // calls Handler to handle requests.
 type Server struct {
-	h Handler
+	h   Handler
+	sec SecurityHandler
 	baseServer
 }
 
 // NewServer creates new Server.
-func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+func NewServer(h Handler, sec SecurityHandler, opts ...ServerOption) (*Server, error) {
 	s, err := newServerConfig(opts...).baseServer()
 	if err != nil {
 		return nil, err
 	}
 	return &Server{
 		h:          h,
+		sec:        sec,
 		baseServer: s,
 	}, nil
 }
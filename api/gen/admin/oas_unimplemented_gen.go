@@ -13,6 +13,53 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AcceptInvitation implements acceptInvitation operation.
+//
+// Accept an invitation and activate the account.
+//
+// POST /auth/invitations/{token}/accept
+func (UnimplementedHandler) AcceptInvitation(ctx context.Context, req *AcceptInvitationRequest, params AcceptInvitationParams) (r AcceptInvitationRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BulkUserAction implements bulkUserAction operation.
+//
+// The action is applied to every eligible user in a single transaction, and the result for each ID
+// is reported. Superadmins can only be changed by superadmins, and callers cannot change themselves.
+// The request is refused when it would leave no active superadmin.
+//
+// POST /users/bulk
+func (UnimplementedHandler) BulkUserAction(ctx context.Context, req *BulkUserActionRequest) (r BulkUserActionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ChangePassword implements changePassword operation.
+//
+// Change the current user's password.
+//
+// POST /auth/password/change
+func (UnimplementedHandler) ChangePassword(ctx context.Context, req *ChangePasswordRequest) (r ChangePasswordRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CompleteSso implements completeSso operation.
+//
+// Complete single sign-on with the code returned by the identity provider.
+//
+// POST /auth/sso/callback
+func (UnimplementedHandler) CompleteSso(ctx context.Context, req *SsoCallbackRequest) (r CompleteSsoRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ConfirmMfa implements confirmMfa operation.
+//
+// Enable MFA by confirming the first code from the authenticator.
+//
+// POST /auth/mfa/confirm
+func (UnimplementedHandler) ConfirmMfa(ctx context.Context, req *ConfirmMfaRequest) (r ConfirmMfaRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ConnectApp implements connectApp operation.
 //
 // Connect an app integration.
@@ -22,6 +69,16 @@ func (UnimplementedHandler) ConnectApp(ctx context.Context, params ConnectAppPar
 	return r, ht.ErrNotImplemented
 }
 
+// CreateApiKey implements createApiKey operation.
+//
+// The key acts with the current user's role, limited to its scopes. The secret is only returned in
+// this response; send it as the bearer token.
+//
+// POST /auth/api-keys
+func (UnimplementedHandler) CreateApiKey(ctx context.Context, req *CreateApiKeyRequest) (r CreateApiKeyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateTask implements createTask operation.
 //
 // Create a new task.
@@ -51,7 +108,8 @@ func (UnimplementedHandler) DeleteTask(ctx context.Context, params DeleteTaskPar
 
 // DeleteUser implements deleteUser operation.
 //
-// Delete a user.
+// Deleted users are hidden from lists and can no longer sign in, but keep their history and can be
+// restored. Their sessions are revoked.
 //
 // DELETE /users/{userId}
 func (UnimplementedHandler) DeleteUser(ctx context.Context, params DeleteUserParams) (r DeleteUserRes, _ error) {
@@ -67,6 +125,34 @@ func (UnimplementedHandler) DisconnectApp(ctx context.Context, params Disconnect
 	return r, ht.ErrNotImplemented
 }
 
+// EnrollMfa implements enrollMfa operation.
+//
+// Replaces any unconfirmed enrolment. MFA stays off until confirmed.
+//
+// POST /auth/mfa/enroll
+func (UnimplementedHandler) EnrollMfa(ctx context.Context) (r EnrollMfaRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ExportUsers implements exportUsers operation.
+//
+// Streams every user matching the filters, newest first. Takes the same filters as listUsers.
+// Password hashes are never exported.
+//
+// GET /users/export
+func (UnimplementedHandler) ExportUsers(ctx context.Context, params ExportUsersParams) (r ExportUsersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ForgotPassword implements forgotPassword operation.
+//
+// Always succeeds so that registered emails cannot be discovered.
+//
+// POST /auth/password/forgot
+func (UnimplementedHandler) ForgotPassword(ctx context.Context, req *ForgotPasswordRequest) error {
+	return ht.ErrNotImplemented
+}
+
 // GetChat implements getChat operation.
 //
 // Get a chat conversation by ID.
@@ -103,6 +189,15 @@ func (UnimplementedHandler) GetDashboardStats(ctx context.Context) (r *Dashboard
 	return r, ht.ErrNotImplemented
 }
 
+// GetInvitation implements getInvitation operation.
+//
+// Look up a pending invitation.
+//
+// GET /auth/invitations/{token}
+func (UnimplementedHandler) GetInvitation(ctx context.Context, params GetInvitationParams) (r GetInvitationRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetRecentSales implements getRecentSales operation.
 //
 // Get recent sales data.
@@ -130,6 +225,28 @@ func (UnimplementedHandler) GetUser(ctx context.Context, params GetUserParams) (
 	return r, ht.ErrNotImplemented
 }
 
+// ImpersonateUser implements impersonateUser operation.
+//
+// For support staff to see exactly what the user sees. The token cannot be refreshed, cannot change
+// passwords, MFA, API keys or roles, and the start and stop of every impersonation are recorded.
+//
+// POST /users/{userId}/impersonate
+func (UnimplementedHandler) ImpersonateUser(ctx context.Context, req *ImpersonateRequest, params ImpersonateUserParams) (r ImpersonateUserRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ImportUsers implements importUsers operation.
+//
+// The CSV header names the columns: email and role are required; firstName, lastName, username,
+// phoneNumber, status and password are optional. Usernames are generated as for createUser when not
+// given. Every row is validated first. Unless dryRun is set, the valid rows are then created in a
+// single transaction and the invalid ones are reported and skipped.
+//
+// POST /users/import
+func (UnimplementedHandler) ImportUsers(ctx context.Context, req *ImportUsersReq) (r ImportUsersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // InviteUser implements inviteUser operation.
 //
 // Invite a new user.
@@ -139,6 +256,15 @@ func (UnimplementedHandler) InviteUser(ctx context.Context, req *InviteUserReque
 	return r, ht.ErrNotImplemented
 }
 
+// ListApiKeys implements listApiKeys operation.
+//
+// List the current user's API keys.
+//
+// GET /auth/api-keys
+func (UnimplementedHandler) ListApiKeys(ctx context.Context) (r *ApiKeyListResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListApps implements listApps operation.
 //
 // List all app integrations.
@@ -157,6 +283,15 @@ func (UnimplementedHandler) ListChats(ctx context.Context, params ListChatsParam
 	return r, ht.ErrNotImplemented
 }
 
+// ListLoginHistory implements listLoginHistory operation.
+//
+// List a user's login attempts, newest first.
+//
+// GET /users/{userId}/login-history
+func (UnimplementedHandler) ListLoginHistory(ctx context.Context, params ListLoginHistoryParams) (r ListLoginHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListTasks implements listTasks operation.
 //
 // List all tasks.
@@ -166,6 +301,15 @@ func (UnimplementedHandler) ListTasks(ctx context.Context, params ListTasksParam
 	return r, ht.ErrNotImplemented
 }
 
+// ListUserSessions implements listUserSessions operation.
+//
+// List a user's active sessions.
+//
+// GET /users/{userId}/sessions
+func (UnimplementedHandler) ListUserSessions(ctx context.Context, params ListUserSessionsParams) (r ListUserSessionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListUsers implements listUsers operation.
 //
 // List all users.
@@ -193,6 +337,98 @@ func (UnimplementedHandler) Logout(ctx context.Context) error {
 	return ht.ErrNotImplemented
 }
 
+// PurgeUser implements purgeUser operation.
+//
+// Removes the user and their credentials for good, freeing the email and username for reuse. Only
+// deleted users can be purged.
+//
+// DELETE /users/{userId}/purge
+func (UnimplementedHandler) PurgeUser(ctx context.Context, params PurgeUserParams) (r PurgeUserRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RefreshToken implements refreshToken operation.
+//
+// Refresh tokens are single use. Each call rotates the refresh token;
+// presenting an already used refresh token revokes the whole session.
+//
+// POST /auth/refresh
+func (UnimplementedHandler) RefreshToken(ctx context.Context, req *RefreshTokenRequest) (r RefreshTokenRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ResendInvitation implements resendInvitation operation.
+//
+// Send a new invitation link, invalidating previous ones.
+//
+// POST /users/{userId}/invitation/resend
+func (UnimplementedHandler) ResendInvitation(ctx context.Context, params ResendInvitationParams) (r ResendInvitationRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ResetPassword implements resetPassword operation.
+//
+// Set a new password using a reset token.
+//
+// POST /auth/password/reset
+func (UnimplementedHandler) ResetPassword(ctx context.Context, req *ResetPasswordRequest) (r ResetPasswordRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ResetUserMfa implements resetUserMfa operation.
+//
+// Turn off a user's MFA so they can enrol again.
+//
+// DELETE /users/{userId}/mfa
+func (UnimplementedHandler) ResetUserMfa(ctx context.Context, params ResetUserMfaParams) (r ResetUserMfaRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RestoreUser implements restoreUser operation.
+//
+// Restore a deleted user.
+//
+// POST /users/{userId}/restore
+func (UnimplementedHandler) RestoreUser(ctx context.Context, params RestoreUserParams) (r RestoreUserRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RevokeApiKey implements revokeApiKey operation.
+//
+// Revoke one of the current user's API keys.
+//
+// DELETE /auth/api-keys/{keyId}
+func (UnimplementedHandler) RevokeApiKey(ctx context.Context, params RevokeApiKeyParams) (r RevokeApiKeyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RevokeInvitation implements revokeInvitation operation.
+//
+// Revoke a pending invitation and remove the invited user.
+//
+// DELETE /users/{userId}/invitation
+func (UnimplementedHandler) RevokeInvitation(ctx context.Context, params RevokeInvitationParams) (r RevokeInvitationRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RevokeUserSession implements revokeUserSession operation.
+//
+// Revoke a single session.
+//
+// DELETE /users/{userId}/sessions/{sessionId}
+func (UnimplementedHandler) RevokeUserSession(ctx context.Context, params RevokeUserSessionParams) (r RevokeUserSessionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RevokeUserSessions implements revokeUserSessions operation.
+//
+// Revoke all of a user's sessions.
+//
+// DELETE /users/{userId}/sessions
+func (UnimplementedHandler) RevokeUserSessions(ctx context.Context, params RevokeUserSessionsParams) (r RevokeUserSessionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SendMessage implements sendMessage operation.
 //
 // Send a message in a chat.
@@ -202,6 +438,35 @@ func (UnimplementedHandler) SendMessage(ctx context.Context, req *SendMessageReq
 	return r, ht.ErrNotImplemented
 }
 
+// StartSso implements startSso operation.
+//
+// Returns the identity provider URL to redirect the browser to. The provider redirects back to the
+// configured callback with a code and the returned state, which are then posted to
+// /auth/sso/callback.
+//
+// POST /auth/sso/start
+func (UnimplementedHandler) StartSso(ctx context.Context) (r StartSsoRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// StopImpersonation implements stopImpersonation operation.
+//
+// End the current impersonation and revoke its token.
+//
+// POST /auth/impersonation/stop
+func (UnimplementedHandler) StopImpersonation(ctx context.Context) (r StopImpersonationRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UnlockUser implements unlockUser operation.
+//
+// Clear failed login attempts and lift a lockout.
+//
+// POST /users/{userId}/unlock
+func (UnimplementedHandler) UnlockUser(ctx context.Context, params UnlockUserParams) (r UnlockUserRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateTask implements updateTask operation.
 //
 // Update a task.
@@ -219,3 +484,12 @@ func (UnimplementedHandler) UpdateTask(ctx context.Context, req *UpdateTaskReque
 func (UnimplementedHandler) UpdateUser(ctx context.Context, req *UpdateUserRequest, params UpdateUserParams) (r UpdateUserRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
+
+// VerifyMfa implements verifyMfa operation.
+//
+// Complete a login with a TOTP or recovery code.
+//
+// POST /auth/mfa/verify
+func (UnimplementedHandler) VerifyMfa(ctx context.Context, req *VerifyMfaRequest) (r VerifyMfaRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
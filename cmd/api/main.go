@@ -1,10 +1,15 @@
 package main
 
 import (
+	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
 	"github.com/sunfmin/shadcn-admin-go/handlers"
 	"github.com/sunfmin/shadcn-admin-go/services"
 	"gorm.io/driver/postgres"
@@ -34,13 +39,94 @@ func main() {
 		handlers.SetHideErrorDetails(true)
 	}
 
+	// Only trust X-Forwarded-For behind a proxy that sets it
+	if os.Getenv("TRUST_PROXY_HEADERS") == "true" {
+		handlers.SetTrustProxyHeaders(true)
+	}
+
+	// Load token signing keys ("kid:secret,..."; the first key signs new tokens)
+	tokenService, err := loadTokenService()
+	if err != nil {
+		log.Fatalf("Failed to configure token signing: %v", err)
+	}
+
+	refreshTTL, err := durationFromEnv("REFRESH_TOKEN_TTL", 30*24*time.Hour)
+	if err != nil {
+		log.Fatalf("Failed to configure refresh tokens: %v", err)
+	}
+
+	impersonationTTL, err := durationFromEnv("IMPERSONATION_TTL", 15*time.Minute)
+	if err != nil {
+		log.Fatalf("Failed to configure impersonation: %v", err)
+	}
+
+	passwordPolicy, err := loadPasswordPolicy()
+	if err != nil {
+		log.Fatalf("Failed to configure password policy: %v", err)
+	}
+
+	throttlePolicy, err := loadLoginThrottlePolicy()
+	if err != nil {
+		log.Fatalf("Failed to configure login throttling: %v", err)
+	}
+
+	mailer, err := loadMailer()
+	if err != nil {
+		log.Fatalf("Failed to configure mail delivery: %v", err)
+	}
+	appURL := os.Getenv("APP_URL")
+	if appURL == "" {
+		appURL = "http://localhost:5173"
+	}
+
+	// Account issuer shown in authenticator apps
+	mfaIssuer := os.Getenv("MFA_ISSUER")
+	if mfaIssuer == "" {
+		mfaIssuer = "Shadcn Admin"
+	}
+
+	// Single sign-on is enabled when an OIDC issuer is configured
+	oidcConfig, err := loadOIDCConfig(appURL)
+	if err != nil {
+		log.Fatalf("Failed to configure single sign-on: %v", err)
+	}
+
 	// Create individual domain services
-	authService := services.NewAuthService(db).Build()
-	userService := services.NewUserService(db).Build()
+	authBuilder := services.NewAuthService(db).
+		WithTokenService(tokenService).
+		WithRefreshTokenTTL(refreshTTL).
+		WithImpersonationTTL(impersonationTTL).
+		WithPasswordPolicy(passwordPolicy).
+		WithLoginThrottlePolicy(throttlePolicy)
+	if oidcConfig != nil {
+		authBuilder.WithOIDC(*oidcConfig)
+	}
+	authService := authBuilder.Build()
+	userService := services.NewUserService(db).
+		WithPasswordPolicy(passwordPolicy).
+		WithMailer(mailer).
+		WithAppURL(appURL).
+		Build()
 	taskService := services.NewTaskService(db).Build()
 	appService := services.NewAppService(db).Build()
 	chatService := services.NewChatService(db).Build()
 	dashboardService := services.NewDashboardService().Build()
+	sessionService := services.NewSessionService(db).Build()
+	invitationService := services.NewInvitationService(db).
+		WithPasswordPolicy(passwordPolicy).
+		WithMailer(mailer).
+		WithAppURL(appURL).
+		Build()
+	passwordService := services.NewPasswordService(db).
+		WithPasswordPolicy(passwordPolicy).
+		WithMailer(mailer).
+		WithAppURL(appURL).
+		Build()
+	mfaService := services.NewMFAService(db).
+		WithIssuer(mfaIssuer).
+		Build()
+	apiKeyService := services.NewAPIKeyService(db).Build()
+	loginHistoryService := services.NewLoginHistoryService(db).Build()
 
 	// Create OgenHandler with all services
 	handler := services.NewOgenHandler().
@@ -50,6 +136,12 @@ func main() {
 		WithAppService(appService).
 		WithChatService(chatService).
 		WithDashboardService(dashboardService).
+		WithSessionService(sessionService).
+		WithInvitationService(invitationService).
+		WithPasswordService(passwordService).
+		WithMFAService(mfaService).
+		WithAPIKeyService(apiKeyService).
+		WithLoginHistoryService(loginHistoryService).
 		Build()
 
 	// Create router with ogen server
@@ -69,3 +161,208 @@ func main() {
 		log.Fatalf("Failed to start server: %v", err)
 	}
 }
+
+// loadTokenService builds the TokenService from TOKEN_SIGNING_KEYS and ACCESS_TOKEN_TTL
+func loadTokenService() (services.TokenService, error) {
+	ids, keys, err := services.ParseSigningKeys(os.Getenv("TOKEN_SIGNING_KEYS"))
+	if err != nil {
+		return nil, err
+	}
+
+	builder := services.NewTokenService()
+	for _, id := range ids {
+		builder.WithKey(id, keys[id])
+	}
+
+	accessTTL, err := durationFromEnv("ACCESS_TOKEN_TTL", 15*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.WithTTL(accessTTL).Build()
+}
+
+// loadPasswordPolicy builds the PasswordPolicy from the PASSWORD_* variables,
+// starting from services.DefaultPasswordPolicy
+func loadPasswordPolicy() (services.PasswordPolicy, error) {
+	policy := services.DefaultPasswordPolicy()
+
+	ints := map[string]*int{
+		"PASSWORD_MIN_LENGTH": &policy.MinLength,
+		"PASSWORD_HISTORY":    &policy.HistorySize,
+		"PASSWORD_HASH_COST":  &policy.HashCost,
+	}
+	for name, target := range ints {
+		if err := intFromEnv(name, target); err != nil {
+			return policy, err
+		}
+	}
+
+	bools := map[string]*bool{
+		"PASSWORD_REQUIRE_UPPER":  &policy.RequireUpper,
+		"PASSWORD_REQUIRE_LOWER":  &policy.RequireLower,
+		"PASSWORD_REQUIRE_DIGIT":  &policy.RequireDigit,
+		"PASSWORD_REQUIRE_SYMBOL": &policy.RequireSymbol,
+	}
+	for name, target := range bools {
+		if err := boolFromEnv(name, target); err != nil {
+			return policy, err
+		}
+	}
+
+	// Newline-separated list of common or breached passwords to reject
+	if path := os.Getenv("PASSWORD_COMMON_LIST"); path != "" {
+		common, err := services.LoadCommonPasswords(path)
+		if err != nil {
+			return policy, err
+		}
+		policy.Common = common
+	}
+
+	return policy, nil
+}
+
+// loadLoginThrottlePolicy builds the LoginThrottlePolicy from the LOGIN_*
+// variables, starting from services.DefaultLoginThrottlePolicy
+func loadLoginThrottlePolicy() (services.LoginThrottlePolicy, error) {
+	policy := services.DefaultLoginThrottlePolicy()
+
+	ints := map[string]*int{
+		"LOGIN_FREE_ATTEMPTS":             &policy.FreeAttempts,
+		"LOGIN_ACCOUNT_LOCKOUT_THRESHOLD": &policy.AccountLockoutThreshold,
+		"LOGIN_IP_LOCKOUT_THRESHOLD":      &policy.IPLockoutThreshold,
+	}
+	for name, target := range ints {
+		if err := intFromEnv(name, target); err != nil {
+			return policy, err
+		}
+	}
+
+	durations := map[string]*time.Duration{
+		"LOGIN_BASE_DELAY":       &policy.BaseDelay,
+		"LOGIN_MAX_DELAY":        &policy.MaxDelay,
+		"LOGIN_LOCKOUT_DURATION": &policy.LockoutDuration,
+		"LOGIN_FAILURE_WINDOW":   &policy.FailureWindow,
+	}
+	for name, target := range durations {
+		d, err := durationFromEnv(name, *target)
+		if err != nil {
+			return policy, err
+		}
+		*target = d
+	}
+
+	return policy, nil
+}
+
+// loadOIDCConfig builds the OIDCConfig from the OIDC_* variables. It returns
+// nil when OIDC_ISSUER is unset, leaving single sign-on disabled.
+func loadOIDCConfig(appURL string) (*services.OIDCConfig, error) {
+	issuer := os.Getenv("OIDC_ISSUER")
+	if issuer == "" {
+		return nil, nil
+	}
+
+	config := &services.OIDCConfig{
+		Issuer:       issuer,
+		ClientID:     os.Getenv("OIDC_CLIENT_ID"),
+		ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+		RedirectURL:  os.Getenv("OIDC_REDIRECT_URL"),
+		Scopes:       strings.Fields(os.Getenv("OIDC_SCOPES")),
+		DefaultRole:  os.Getenv("OIDC_DEFAULT_ROLE"),
+	}
+	if config.ClientID == "" {
+		return nil, fmt.Errorf("OIDC_CLIENT_ID is required with OIDC_ISSUER")
+	}
+	if config.RedirectURL == "" {
+		config.RedirectURL = strings.TrimSuffix(appURL, "/") + "/sso/callback"
+	}
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"email", "profile"}
+	}
+	if config.DefaultRole == "" {
+		config.DefaultRole = string(api.UserRoleCashier)
+	}
+	if err := api.UserRole(config.DefaultRole).Validate(); err != nil {
+		return nil, fmt.Errorf("parse OIDC_DEFAULT_ROLE: %w", err)
+	}
+	if err := boolFromEnv("OIDC_AUTO_PROVISION", &config.AutoProvision); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// loadMailer builds the Mailer from the SMTP_* variables. Without SMTP_HOST,
+// mail is logged only when MAIL_LOG_DEV is set, since messages carry sign-in
+// links that must not end up in production logs.
+func loadMailer() (services.Mailer, error) {
+	host := os.Getenv("SMTP_HOST")
+	if host == "" {
+		var dev bool
+		if err := boolFromEnv("MAIL_LOG_DEV", &dev); err != nil {
+			return nil, err
+		}
+		if !dev {
+			return nil, fmt.Errorf("SMTP_HOST is required unless MAIL_LOG_DEV is set")
+		}
+		log.Printf("Logging outgoing mail instead of sending it (MAIL_LOG_DEV)")
+		return services.NewLogMailer(), nil
+	}
+
+	config := services.SMTPConfig{
+		Host:     host,
+		Port:     587,
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("SMTP_FROM"),
+	}
+	if err := intFromEnv("SMTP_PORT", &config.Port); err != nil {
+		return nil, err
+	}
+	if config.From == "" {
+		return nil, fmt.Errorf("SMTP_FROM is required with SMTP_HOST")
+	}
+	return services.NewSMTPMailer(config), nil
+}
+
+// intFromEnv overwrites target with the integer in the environment, if set
+func intFromEnv(name string, target *int) error {
+	value := os.Getenv(name)
+	if value == "" {
+		return nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("parse %s: %w", name, err)
+	}
+	*target = n
+	return nil
+}
+
+// boolFromEnv overwrites target with the boolean in the environment, if set
+func boolFromEnv(name string, target *bool) error {
+	value := os.Getenv(name)
+	if value == "" {
+		return nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("parse %s: %w", name, err)
+	}
+	*target = b
+	return nil
+}
+
+// durationFromEnv parses a duration such as "15m" from the environment
+func durationFromEnv(name string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("parse %s: %w", name, err)
+	}
+	return d, nil
+}
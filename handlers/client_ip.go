@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"net"
+	"strings"
+
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/sunfmin/shadcn-admin-go/services"
+)
+
+// trustProxyHeaders controls whether X-Forwarded-For is used for the client IP
+var trustProxyHeaders bool
+
+// SetTrustProxyHeaders configures whether the client IP is taken from
+// X-Forwarded-For. Only enable it behind a proxy that overwrites the header.
+func SetTrustProxyHeaders(trust bool) {
+	trustProxyHeaders = trust
+}
+
+// clientIPMiddleware places the caller's IP address and User-Agent on the request context
+func clientIPMiddleware(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+	ctx := services.ContextWithClientIP(req.Context, clientIP(req.Raw.RemoteAddr, req.Raw.Header.Get("X-Forwarded-For")))
+	req.SetContext(services.ContextWithUserAgent(ctx, req.Raw.UserAgent()))
+	return next(req)
+}
+
+// clientIP returns the caller's address from the connection or, when trusted,
+// the first X-Forwarded-For entry
+func clientIP(remoteAddr, forwardedFor string) string {
+	if trustProxyHeaders && forwardedFor != "" {
+		first, _, _ := strings.Cut(forwardedFor, ",")
+		return strings.TrimSpace(first)
+	}
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
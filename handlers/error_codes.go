@@ -17,14 +17,42 @@ type ErrorCode struct {
 // errorCodes is the singleton containing all error codes
 var errorCodes = struct {
 	// Service errors (mapped from services.Err*)
-	UserNotFound       ErrorCode
-	TaskNotFound       ErrorCode
-	AppNotFound        ErrorCode
-	ChatNotFound       ErrorCode
-	InvalidCredentials ErrorCode
-	Unauthorized       ErrorCode
-	DuplicateEmail     ErrorCode
-	DuplicateUsername  ErrorCode
+	UserNotFound        ErrorCode
+	TaskNotFound        ErrorCode
+	AppNotFound         ErrorCode
+	ChatNotFound        ErrorCode
+	InvalidCredentials  ErrorCode
+	AccountSuspended    ErrorCode
+	AccountInactive     ErrorCode
+	InvitationPending   ErrorCode
+	Unauthorized        ErrorCode
+	Forbidden           ErrorCode
+	DuplicateEmail      ErrorCode
+	DuplicateUsername   ErrorCode
+	UserNotInvited      ErrorCode
+	InvalidRefreshToken ErrorCode
+	RefreshTokenReused  ErrorCode
+	InvalidResetToken   ErrorCode
+	IncorrectPassword   ErrorCode
+	PasswordPolicy      ErrorCode
+	TooManyAttempts     ErrorCode
+	MFAAlreadyEnabled   ErrorCode
+	MFANotEnrolled      ErrorCode
+	InvalidMFACode      ErrorCode
+	MFAFailed           ErrorCode
+	SSONotConfigured    ErrorCode
+	SSOFailed           ErrorCode
+	SSOAccountNotFound  ErrorCode
+	InvalidAPIKeyExpiry ErrorCode
+	CannotImpersonate   ErrorCode
+	NotImpersonating    ErrorCode
+	InvalidSort         ErrorCode
+	InvalidCursor       ErrorCode
+	InvalidImport       ErrorCode
+	UserNotDeleted      ErrorCode
+	InvalidBulkAction   ErrorCode
+	LastSuperadmin      ErrorCode
+	PreconditionFailed  ErrorCode
 
 	// HTTP-only errors (no service mapping)
 	BadRequest       ErrorCode
@@ -63,12 +91,36 @@ var errorCodes = struct {
 		HTTPStatus: http.StatusUnauthorized,
 		ServiceErr: services.ErrInvalidCredentials,
 	},
+	AccountSuspended: ErrorCode{
+		Code:       "ACCOUNT_SUSPENDED",
+		Message:    "This account has been suspended",
+		HTTPStatus: http.StatusForbidden,
+		ServiceErr: services.ErrAccountSuspended,
+	},
+	AccountInactive: ErrorCode{
+		Code:       "ACCOUNT_INACTIVE",
+		Message:    "This account is inactive",
+		HTTPStatus: http.StatusForbidden,
+		ServiceErr: services.ErrAccountInactive,
+	},
+	InvitationPending: ErrorCode{
+		Code:       "INVITATION_PENDING",
+		Message:    "The invitation for this account has not been accepted yet",
+		HTTPStatus: http.StatusForbidden,
+		ServiceErr: services.ErrInvitationPending,
+	},
 	Unauthorized: ErrorCode{
 		Code:       "UNAUTHORIZED",
 		Message:    "Authentication required",
 		HTTPStatus: http.StatusUnauthorized,
 		ServiceErr: services.ErrUnauthorized,
 	},
+	Forbidden: ErrorCode{
+		Code:       "FORBIDDEN",
+		Message:    "You do not have permission to perform this operation",
+		HTTPStatus: http.StatusForbidden,
+		ServiceErr: services.ErrForbidden,
+	},
 	DuplicateEmail: ErrorCode{
 		Code:       "DUPLICATE_EMAIL",
 		Message:    "Email already exists",
@@ -81,6 +133,150 @@ var errorCodes = struct {
 		HTTPStatus: http.StatusConflict,
 		ServiceErr: services.ErrDuplicateUsername,
 	},
+	UserNotInvited: ErrorCode{
+		Code:       "USER_NOT_INVITED",
+		Message:    "User has no pending invitation",
+		HTTPStatus: http.StatusConflict,
+		ServiceErr: services.ErrUserNotInvited,
+	},
+	InvalidRefreshToken: ErrorCode{
+		Code:       "INVALID_REFRESH_TOKEN",
+		Message:    "Refresh token is invalid or expired",
+		HTTPStatus: http.StatusUnauthorized,
+		ServiceErr: services.ErrInvalidRefreshToken,
+	},
+	RefreshTokenReused: ErrorCode{
+		Code:       "REFRESH_TOKEN_REUSED",
+		Message:    "Refresh token was already used; the session has been revoked",
+		HTTPStatus: http.StatusUnauthorized,
+		ServiceErr: services.ErrRefreshTokenReused,
+	},
+	InvalidResetToken: ErrorCode{
+		Code:       "INVALID_RESET_TOKEN",
+		Message:    "Password reset link is invalid, expired or already used",
+		HTTPStatus: http.StatusBadRequest,
+		ServiceErr: services.ErrInvalidResetToken,
+	},
+	IncorrectPassword: ErrorCode{
+		Code:       "INCORRECT_PASSWORD",
+		Message:    "Current password is incorrect",
+		HTTPStatus: http.StatusBadRequest,
+		ServiceErr: services.ErrIncorrectPassword,
+	},
+	PasswordPolicy: ErrorCode{
+		Code:       "PASSWORD_POLICY_VIOLATION",
+		Message:    "Password does not meet the password policy",
+		HTTPStatus: http.StatusBadRequest,
+		ServiceErr: services.ErrPasswordPolicy,
+	},
+	TooManyAttempts: ErrorCode{
+		Code:       "TOO_MANY_ATTEMPTS",
+		Message:    "Too many failed attempts; try again later",
+		HTTPStatus: http.StatusTooManyRequests,
+		ServiceErr: services.ErrTooManyAttempts,
+	},
+	MFAAlreadyEnabled: ErrorCode{
+		Code:       "MFA_ALREADY_ENABLED",
+		Message:    "Two-factor authentication is already enabled",
+		HTTPStatus: http.StatusConflict,
+		ServiceErr: services.ErrMFAAlreadyEnabled,
+	},
+	MFANotEnrolled: ErrorCode{
+		Code:       "MFA_NOT_ENROLLED",
+		Message:    "Start two-factor enrolment before confirming it",
+		HTTPStatus: http.StatusBadRequest,
+		ServiceErr: services.ErrMFANotEnrolled,
+	},
+	InvalidMFACode: ErrorCode{
+		Code:       "INVALID_MFA_CODE",
+		Message:    "The authentication code is invalid",
+		HTTPStatus: http.StatusBadRequest,
+		ServiceErr: services.ErrInvalidMFACode,
+	},
+	MFAFailed: ErrorCode{
+		Code:       "MFA_FAILED",
+		Message:    "Two-factor verification failed; log in again if the challenge expired",
+		HTTPStatus: http.StatusUnauthorized,
+		ServiceErr: services.ErrMFAFailed,
+	},
+	SSONotConfigured: ErrorCode{
+		Code:       "SSO_NOT_CONFIGURED",
+		Message:    "Single sign-on is not configured",
+		HTTPStatus: http.StatusNotFound,
+		ServiceErr: services.ErrSSONotConfigured,
+	},
+	SSOFailed: ErrorCode{
+		Code:       "SSO_FAILED",
+		Message:    "Single sign-on failed; please start again",
+		HTTPStatus: http.StatusUnauthorized,
+		ServiceErr: services.ErrSSOFailed,
+	},
+	SSOAccountNotFound: ErrorCode{
+		Code:       "SSO_ACCOUNT_NOT_FOUND",
+		Message:    "No account exists for this identity",
+		HTTPStatus: http.StatusForbidden,
+		ServiceErr: services.ErrSSOAccountNotFound,
+	},
+	InvalidAPIKeyExpiry: ErrorCode{
+		Code:       "INVALID_API_KEY_EXPIRY",
+		Message:    "API key expiry must be in the future",
+		HTTPStatus: http.StatusBadRequest,
+		ServiceErr: services.ErrInvalidAPIKeyExpiry,
+	},
+	CannotImpersonate: ErrorCode{
+		Code:       "CANNOT_IMPERSONATE",
+		Message:    "This user cannot be impersonated",
+		HTTPStatus: http.StatusForbidden,
+		ServiceErr: services.ErrCannotImpersonate,
+	},
+	NotImpersonating: ErrorCode{
+		Code:       "NOT_IMPERSONATING",
+		Message:    "The current session is not an impersonation",
+		HTTPStatus: http.StatusConflict,
+		ServiceErr: services.ErrNotImpersonating,
+	},
+	InvalidSort: ErrorCode{
+		Code:       "INVALID_SORT",
+		Message:    "Unsupported sort field",
+		HTTPStatus: http.StatusBadRequest,
+		ServiceErr: services.ErrInvalidSort,
+	},
+	InvalidCursor: ErrorCode{
+		Code:       "INVALID_CURSOR",
+		Message:    "Invalid or outdated pagination cursor",
+		HTTPStatus: http.StatusBadRequest,
+		ServiceErr: services.ErrInvalidCursor,
+	},
+	InvalidImport: ErrorCode{
+		Code:       "INVALID_IMPORT",
+		Message:    "The file is not a valid user import CSV",
+		HTTPStatus: http.StatusBadRequest,
+		ServiceErr: services.ErrInvalidImport,
+	},
+	UserNotDeleted: ErrorCode{
+		Code:       "USER_NOT_DELETED",
+		Message:    "Only deleted users can be purged",
+		HTTPStatus: http.StatusConflict,
+		ServiceErr: services.ErrUserNotDeleted,
+	},
+	InvalidBulkAction: ErrorCode{
+		Code:       "INVALID_BULK_ACTION",
+		Message:    "The bulk action is missing its status or role, or sets an unsupported status",
+		HTTPStatus: http.StatusBadRequest,
+		ServiceErr: services.ErrInvalidBulkAction,
+	},
+	LastSuperadmin: ErrorCode{
+		Code:       "LAST_SUPERADMIN",
+		Message:    "At least one active superadmin must remain",
+		HTTPStatus: http.StatusConflict,
+		ServiceErr: services.ErrLastSuperadmin,
+	},
+	PreconditionFailed: ErrorCode{
+		Code:       "PRECONDITION_FAILED",
+		Message:    "The record was changed since it was read; reload it and try again",
+		HTTPStatus: http.StatusPreconditionFailed,
+		ServiceErr: services.ErrPreconditionFailed,
+	},
 
 	// HTTP-only errors
 	BadRequest: ErrorCode{
@@ -116,9 +312,37 @@ func AllErrors() []ErrorCode {
 		errorCodes.AppNotFound,
 		errorCodes.ChatNotFound,
 		errorCodes.InvalidCredentials,
+		errorCodes.AccountSuspended,
+		errorCodes.AccountInactive,
+		errorCodes.InvitationPending,
 		errorCodes.Unauthorized,
+		errorCodes.Forbidden,
 		errorCodes.DuplicateEmail,
 		errorCodes.DuplicateUsername,
+		errorCodes.UserNotInvited,
+		errorCodes.InvalidRefreshToken,
+		errorCodes.RefreshTokenReused,
+		errorCodes.InvalidResetToken,
+		errorCodes.IncorrectPassword,
+		errorCodes.PasswordPolicy,
+		errorCodes.TooManyAttempts,
+		errorCodes.MFAAlreadyEnabled,
+		errorCodes.MFANotEnrolled,
+		errorCodes.InvalidMFACode,
+		errorCodes.MFAFailed,
+		errorCodes.SSONotConfigured,
+		errorCodes.SSOFailed,
+		errorCodes.SSOAccountNotFound,
+		errorCodes.InvalidAPIKeyExpiry,
+		errorCodes.CannotImpersonate,
+		errorCodes.NotImpersonating,
+		errorCodes.InvalidSort,
+		errorCodes.InvalidCursor,
+		errorCodes.InvalidImport,
+		errorCodes.UserNotDeleted,
+		errorCodes.InvalidBulkAction,
+		errorCodes.LastSuperadmin,
+		errorCodes.PreconditionFailed,
 		errorCodes.BadRequest,
 		errorCodes.InternalError,
 		errorCodes.RequestCancelled,
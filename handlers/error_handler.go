@@ -4,9 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/ogen-go/ogen/ogenerrors"
 	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/services"
 )
 
 // hideErrorDetails controls whether error details are included in responses
@@ -18,12 +23,29 @@ func SetHideErrorDetails(hide bool) {
 	hideErrorDetails = hide
 }
 
+// fieldViolationError is implemented by service errors that reject individual request fields
+type fieldViolationError interface {
+	error
+	FieldViolations() []services.FieldViolation
+}
+
+// retryAfterError is implemented by service errors that ask the client to wait
+type retryAfterError interface {
+	error
+	RetryAfter() time.Duration
+}
+
 // OgenErrorHandler implements ogenerrors.ErrorHandler for ogen servers
 // It maps service sentinel errors to user-friendly HTTP responses
 func OgenErrorHandler(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
 	errCode := mapServiceError(err)
 
 	w.Header().Set("Content-Type", "application/json")
+	var retryErr retryAfterError
+	if errors.As(err, &retryErr) {
+		seconds := max(1, int(math.Ceil(retryErr.RetryAfter().Seconds())))
+		w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	}
 	w.WriteHeader(errCode.HTTPStatus)
 
 	resp := api.ErrorResponse{
@@ -31,12 +53,24 @@ func OgenErrorHandler(ctx context.Context, w http.ResponseWriter, r *http.Reques
 		Message: errCode.Message,
 	}
 
+	// Field violations tell the client what to fix, so they are never hidden
+	var fieldErr fieldViolationError
+	if errors.As(err, &fieldErr) {
+		for _, v := range fieldErr.FieldViolations() {
+			resp.Fields = append(resp.Fields, api.FieldError{
+				Field:   v.Field,
+				Code:    v.Code,
+				Message: v.Message,
+			})
+		}
+	}
+
 	// Include details in development only
 	if !hideErrorDetails && err != nil {
 		resp.Details.SetTo(err.Error())
 	}
 
-	json.NewEncoder(w).Encode(resp)
+	json.NewEncoder(w).Encode(&resp)
 }
 
 // mapServiceError finds the matching ErrorCode for a service error
@@ -49,6 +83,11 @@ func mapServiceError(err error) ErrorCode {
 		return Errors.RequestTimeout
 	}
 
+	// Missing credentials on a secured operation
+	if errors.Is(err, ogenerrors.ErrSecurityRequirementIsNotSatisfied) {
+		return Errors.Unauthorized
+	}
+
 	// Check service sentinel errors
 	for _, errCode := range AllErrors() {
 		if errCode.ServiceErr != nil && errors.Is(err, errCode.ServiceErr) {
@@ -6,23 +6,32 @@ import (
 	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
 )
 
+// Handler is an ogen operation handler that also authenticates requests
+type Handler interface {
+	api.Handler
+	api.SecurityHandler
+}
+
 // NewServer creates an ogen server with proper error handling configured
 // This wrapper ensures all service errors are mapped to user-friendly HTTP responses
-func NewServer(h api.Handler) (*api.Server, error) {
+// and that services can read the client IP from the request context
+func NewServer(h Handler) (*api.Server, error) {
 	return api.NewServer(
+		h,
 		h,
 		api.WithErrorHandler(OgenErrorHandler),
+		api.WithMiddleware(clientIPMiddleware),
 	)
 }
 
 // RouterBuilder builds an HTTP router with the ogen server and optional middleware
 type RouterBuilder struct {
-	handler     api.Handler
+	handler     Handler
 	middlewares []func(http.Handler) http.Handler
 }
 
 // NewRouter creates a new RouterBuilder
-func NewRouter(handler api.Handler) *RouterBuilder {
+func NewRouter(handler Handler) *RouterBuilder {
 	return &RouterBuilder{
 		handler:     handler,
 		middlewares: make([]func(http.Handler) http.Handler, 0),
@@ -20,6 +20,155 @@ type User struct {
 	Role        string    `gorm:"not null;default:'cashier'"`
 	CreatedAt   time.Time `gorm:"autoCreateTime"`
 	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+	// Version is incremented by every update, for optimistic concurrency
+	Version int `gorm:"not null;default:1"`
+	// DeletedAt soft-deletes the user; deleted users are excluded from queries
+	// unless Unscoped
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+// Session represents an authenticated login, keyed by its access token ID
+type Session struct {
+	ID        string    `gorm:"primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;index;not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	// ImpersonatorID is the superadmin acting as the user in this session, if any
+	ImpersonatorID *uuid.UUID `gorm:"type:uuid"`
+}
+
+// RefreshToken is a single-use refresh token. All tokens rotated from the same
+// login share the session's ID as their family.
+type RefreshToken struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	SessionID string    `gorm:"index;not null"`
+	UserID    uuid.UUID `gorm:"type:uuid;index;not null"`
+	TokenHash string    `gorm:"uniqueIndex;not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// Invitation is a single-use onboarding link for an invited user
+type Invitation struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID     uuid.UUID `gorm:"type:uuid;index;not null"`
+	TokenHash  string    `gorm:"uniqueIndex;not null"`
+	ExpiresAt  time.Time `gorm:"not null"`
+	AcceptedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+// PasswordReset is a single-use link for setting a new password
+type PasswordReset struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID    uuid.UUID `gorm:"type:uuid;index;not null"`
+	TokenHash string    `gorm:"uniqueIndex;not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// PasswordHistory is a user's previous password hash, kept to prevent reuse
+type PasswordHistory struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID       uuid.UUID `gorm:"type:uuid;index;not null"`
+	PasswordHash string    `gorm:"not null"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+}
+
+// LoginThrottle counts recent failed logins for an account or a client IP
+type LoginThrottle struct {
+	Kind          string `gorm:"primaryKey"`
+	Subject       string `gorm:"primaryKey"`
+	Failures      int    `gorm:"not null;default:0"`
+	LastFailureAt time.Time
+	BlockedUntil  time.Time `gorm:"index"`
+	// RejectionRecordedUntil is the BlockedUntil of the block whose refused
+	// attempt is already in the login history
+	RejectionRecordedUntil *time.Time
+}
+
+// UserMFA is a user's TOTP enrolment. MFA is enabled once ConfirmedAt is set.
+type UserMFA struct {
+	UserID      uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Secret      string    `gorm:"not null"`
+	ConfirmedAt *time.Time
+	// LastUsedStep is the time step of the last accepted code, so it cannot be replayed
+	LastUsedStep int64     `gorm:"not null;default:0"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+}
+
+// MFARecoveryCode is a single-use backup code for logging in without the authenticator
+type MFARecoveryCode struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID    uuid.UUID `gorm:"type:uuid;index;not null"`
+	CodeHash  string    `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// MFAChallenge is the pending second step of a login that passed the password check
+type MFAChallenge struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID    uuid.UUID `gorm:"type:uuid;index;not null"`
+	TokenHash string    `gorm:"uniqueIndex;not null"`
+	Attempts  int       `gorm:"not null;default:0"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// LoginEvent records a login attempt. UserID is nil when the email matched no account.
+type LoginEvent struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID        *uuid.UUID `gorm:"type:uuid;index:idx_login_events_user_created,priority:1"`
+	Email         string     `gorm:"not null"`
+	Method        string     `gorm:"not null"`
+	Outcome       string     `gorm:"not null"`
+	FailureReason string
+	IPAddress     string
+	UserAgent     string
+	CreatedAt     time.Time `gorm:"autoCreateTime;index:idx_login_events_user_created,priority:2"`
+}
+
+// ImpersonationEvent records a superadmin starting or stopping an impersonation
+type ImpersonationEvent struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	ImpersonatorID uuid.UUID `gorm:"type:uuid;index;not null"`
+	UserID         uuid.UUID `gorm:"type:uuid;index;not null"`
+	SessionID      string    `gorm:"index;not null"`
+	Action         string    `gorm:"not null"`
+	Reason         string
+	IPAddress      string
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+}
+
+// APIKey is a personal API key that acts as its owner. Only a hash of the
+// secret is stored.
+type APIKey struct {
+	ID      uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID  uuid.UUID `gorm:"type:uuid;index;not null"`
+	Name    string    `gorm:"not null"`
+	Prefix  string    `gorm:"not null"`
+	KeyHash string    `gorm:"uniqueIndex;not null"`
+	// Scopes is the space-separated list of granted scopes
+	Scopes     string `gorm:"not null"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+// SSOState is a pending single sign-on authorization request, deleted when the
+// provider's callback is completed
+type SSOState struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	StateHash    string    `gorm:"uniqueIndex;not null"`
+	Nonce        string    `gorm:"not null"`
+	CodeVerifier string    `gorm:"not null"`
+	ExpiresAt    time.Time `gorm:"index;not null"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
 }
 
 // Task represents a task in the system
@@ -34,6 +183,8 @@ type Task struct {
 	DueDate     *time.Time
 	CreatedAt   time.Time `gorm:"autoCreateTime"`
 	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+	// Version is incremented by every update, for optimistic concurrency
+	Version int `gorm:"not null;default:1"`
 }
 
 // BeforeCreate generates a task ID in format TASK-XXXX
@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+	"gorm.io/gorm"
+)
+
+// apiKeyPrefix marks a bearer token as an API key rather than an access token
+const apiKeyPrefix = "sak_"
+
+// apiKeyDisplayLength is how much of the secret is kept to tell keys apart
+const apiKeyDisplayLength = len(apiKeyPrefix) + 8
+
+// apiKeyLastUsedResolution limits last-used tracking to one write per key per interval
+const apiKeyLastUsedResolution = time.Minute
+
+// APIKeyService interface for managing the current user's API keys
+type APIKeyService interface {
+	List(ctx context.Context) (*api.ApiKeyListResponse, error)
+	Create(ctx context.Context, req *api.CreateApiKeyRequest) (api.CreateApiKeyRes, error)
+	Revoke(ctx context.Context, params api.RevokeApiKeyParams) (api.RevokeApiKeyRes, error)
+}
+
+// apiKeyServiceImpl implements APIKeyService
+type apiKeyServiceImpl struct {
+	db *gorm.DB
+}
+
+// apiKeyServiceBuilder is the builder for APIKeyService
+type apiKeyServiceBuilder struct {
+	db *gorm.DB
+}
+
+// NewAPIKeyService creates a new APIKeyService builder
+func NewAPIKeyService(db *gorm.DB) *apiKeyServiceBuilder {
+	return &apiKeyServiceBuilder{db: db}
+}
+
+// Build creates the APIKeyService
+func (b *apiKeyServiceBuilder) Build() APIKeyService {
+	return &apiKeyServiceImpl{db: b.db}
+}
+
+// List implements APIKeyService
+func (s *apiKeyServiceImpl) List(ctx context.Context) (*api.ApiKeyListResponse, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+
+	var keys []models.APIKey
+	if err := s.db.WithContext(ctx).
+		Where("user_id = ?", principal.UserID).
+		Order("created_at DESC").
+		Find(&keys).Error; err != nil {
+		return nil, fmt.Errorf("list api keys: %w", err)
+	}
+
+	data := make([]api.ApiKey, len(keys))
+	for i, key := range keys {
+		data[i] = apiKeyToAPI(key)
+	}
+
+	return &api.ApiKeyListResponse{Data: data}, nil
+}
+
+// Create implements APIKeyService
+func (s *apiKeyServiceImpl) Create(ctx context.Context, req *api.CreateApiKeyRequest) (api.CreateApiKeyRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+
+	var scopes []string
+	for _, scope := range req.Scopes {
+		if !slices.Contains(scopes, string(scope)) {
+			scopes = append(scopes, string(scope))
+		}
+	}
+
+	secret, err := generateSecret(32)
+	if err != nil {
+		return nil, err
+	}
+	secret = apiKeyPrefix + secret
+
+	key := &models.APIKey{
+		UserID:  principal.UserID,
+		Name:    req.Name,
+		Prefix:  secret[:apiKeyDisplayLength],
+		KeyHash: hashSecret(secret),
+		Scopes:  strings.Join(scopes, " "),
+	}
+	if expiresAt, ok := req.ExpiresAt.Get(); ok {
+		if !expiresAt.After(time.Now()) {
+			return nil, ErrInvalidAPIKeyExpiry
+		}
+		key.ExpiresAt = &expiresAt
+	}
+
+	if err := s.db.WithContext(ctx).Create(key).Error; err != nil {
+		return nil, fmt.Errorf("create api key: %w", err)
+	}
+
+	return &api.CreatedApiKey{ApiKey: apiKeyToAPI(*key), Secret: secret}, nil
+}
+
+// Revoke implements APIKeyService
+func (s *apiKeyServiceImpl) Revoke(ctx context.Context, params api.RevokeApiKeyParams) (api.RevokeApiKeyRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+
+	keyID, err := uuid.Parse(params.KeyId)
+	if err != nil {
+		return &api.RevokeApiKeyNotFound{}, nil
+	}
+
+	result := s.db.WithContext(ctx).
+		Where("id = ? AND user_id = ?", keyID, principal.UserID).
+		Delete(&models.APIKey{})
+	if result.Error != nil {
+		return nil, fmt.Errorf("revoke api key: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return &api.RevokeApiKeyNotFound{}, nil
+	}
+
+	return &api.RevokeApiKeyNoContent{}, nil
+}
+
+// authenticateAPIKey resolves an API key to a principal acting as its owner
+func authenticateAPIKey(ctx context.Context, db *gorm.DB, secret string) (*Principal, error) {
+	now := time.Now()
+
+	var key models.APIKey
+	if err := db.WithContext(ctx).Where("key_hash = ?", hashSecret(secret)).First(&key).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("unknown api key: %w", ErrUnauthorized)
+		}
+		return nil, fmt.Errorf("get api key: %w", err)
+	}
+	if key.ExpiresAt != nil && !now.Before(*key.ExpiresAt) {
+		return nil, fmt.Errorf("api key %s expired: %w", key.ID, ErrUnauthorized)
+	}
+
+	// The key carries its owner's current role and stops working with the account
+	var user models.User
+	if err := db.WithContext(ctx).Select("id", "role", "status").Where("id = ?", key.UserID).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("api key %s owner: %w", key.ID, ErrUnauthorized)
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+	if user.Status != "active" {
+		return nil, fmt.Errorf("user %s is %s: %w", user.ID, user.Status, ErrUnauthorized)
+	}
+
+	if err := db.WithContext(ctx).Model(&models.APIKey{}).
+		Where("id = ? AND (last_used_at IS NULL OR last_used_at < ?)", key.ID, now.Add(-apiKeyLastUsedResolution)).
+		Update("last_used_at", now).Error; err != nil {
+		return nil, fmt.Errorf("record api key use: %w", err)
+	}
+
+	principal := &Principal{
+		UserID:   user.ID,
+		Role:     user.Role,
+		APIKeyID: key.ID,
+		Scopes:   strings.Fields(key.Scopes),
+	}
+	if key.ExpiresAt != nil {
+		principal.ExpiresAt = *key.ExpiresAt
+	}
+	return principal, nil
+}
+
+// apiKeyToAPI converts a models.APIKey to api.ApiKey
+func apiKeyToAPI(k models.APIKey) api.ApiKey {
+	scopes := strings.Fields(k.Scopes)
+	result := api.ApiKey{
+		ID:        k.ID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Scopes:    make([]api.ApiKeyScope, len(scopes)),
+		CreatedAt: k.CreatedAt,
+	}
+	for i, scope := range scopes {
+		result.Scopes[i] = api.ApiKeyScope(scope)
+	}
+	if k.ExpiresAt != nil {
+		result.ExpiresAt = api.NewOptDateTime(*k.ExpiresAt)
+	}
+	if k.LastUsedAt != nil {
+		result.LastUsedAt = api.NewOptDateTime(*k.LastUsedAt)
+	}
+	return result
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
 	"github.com/sunfmin/shadcn-admin-go/internal/models"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // AuthService interface for authentication operations
@@ -17,26 +20,116 @@ type AuthService interface {
 	Login(ctx context.Context, req *api.LoginRequest) (api.LoginRes, error)
 	Logout(ctx context.Context) error
 	GetCurrentUser(ctx context.Context) (api.GetCurrentUserRes, error)
+	Refresh(ctx context.Context, req *api.RefreshTokenRequest) (api.RefreshTokenRes, error)
+	// VerifyMFA completes a login that returned an MFA challenge
+	VerifyMFA(ctx context.Context, req *api.VerifyMfaRequest) (api.VerifyMfaRes, error)
+	// StartSSO creates an authorization request at the identity provider
+	StartSSO(ctx context.Context) (api.StartSsoRes, error)
+	// CompleteSSO logs in with the code the identity provider returned
+	CompleteSSO(ctx context.Context, req *api.SsoCallbackRequest) (api.CompleteSsoRes, error)
+	// Impersonate issues a short-lived token acting as another user
+	Impersonate(ctx context.Context, req *api.ImpersonateRequest, params api.ImpersonateUserParams) (api.ImpersonateUserRes, error)
+	// StopImpersonation ends the impersonation the current token belongs to
+	StopImpersonation(ctx context.Context) (api.StopImpersonationRes, error)
+	// Authenticate validates a bearer access token and returns its principal
+	Authenticate(ctx context.Context, token string) (*Principal, error)
 }
 
+// defaultRefreshTokenTTL is the default lifetime of a refresh token and its session
+const defaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+// defaultImpersonationTTL is the default lifetime of an impersonation token
+const defaultImpersonationTTL = 15 * time.Minute
+
+// Impersonation audit actions
+const (
+	impersonationStarted = "start"
+	impersonationStopped = "stop"
+)
+
 // authServiceImpl implements AuthService
 type authServiceImpl struct {
-	db *gorm.DB
+	db               *gorm.DB
+	tokens           TokenService
+	refreshTTL       time.Duration
+	impersonationTTL time.Duration
+	policy           PasswordPolicy
+	throttle         loginThrottle
+	oidc             *oidcProvider
 }
 
 // authServiceBuilder is the builder for AuthService
 type authServiceBuilder struct {
-	db *gorm.DB
+	db               *gorm.DB
+	tokens           TokenService
+	refreshTTL       time.Duration
+	impersonationTTL time.Duration
+	policy           PasswordPolicy
+	throttle         LoginThrottlePolicy
+	oidc             *OIDCConfig
 }
 
 // NewAuthService creates a new AuthService builder
 func NewAuthService(db *gorm.DB) *authServiceBuilder {
-	return &authServiceBuilder{db: db}
+	return &authServiceBuilder{
+		db:               db,
+		refreshTTL:       defaultRefreshTokenTTL,
+		impersonationTTL: defaultImpersonationTTL,
+		policy:           DefaultPasswordPolicy(),
+		throttle:         DefaultLoginThrottlePolicy(),
+	}
+}
+
+// WithRefreshTokenTTL sets how long refresh tokens (and their sessions) stay valid
+func (b *authServiceBuilder) WithRefreshTokenTTL(ttl time.Duration) *authServiceBuilder {
+	b.refreshTTL = ttl
+	return b
+}
+
+// WithImpersonationTTL sets how long impersonation tokens stay valid; they cannot be refreshed
+func (b *authServiceBuilder) WithImpersonationTTL(ttl time.Duration) *authServiceBuilder {
+	b.impersonationTTL = ttl
+	return b
+}
+
+// WithTokenService sets the service used to sign access tokens
+func (b *authServiceBuilder) WithTokenService(tokens TokenService) *authServiceBuilder {
+	b.tokens = tokens
+	return b
+}
+
+// WithPasswordPolicy sets the policy whose hash cost stored passwords are upgraded to
+func (b *authServiceBuilder) WithPasswordPolicy(policy PasswordPolicy) *authServiceBuilder {
+	b.policy = policy
+	return b
+}
+
+// WithLoginThrottlePolicy sets how failed logins delay and lock out further attempts
+func (b *authServiceBuilder) WithLoginThrottlePolicy(policy LoginThrottlePolicy) *authServiceBuilder {
+	b.throttle = policy
+	return b
+}
+
+// WithOIDC enables single sign-on through the OpenID Connect provider
+func (b *authServiceBuilder) WithOIDC(config OIDCConfig) *authServiceBuilder {
+	b.oidc = &config
+	return b
 }
 
 // Build creates the AuthService
 func (b *authServiceBuilder) Build() AuthService {
-	return &authServiceImpl{db: b.db}
+	s := &authServiceImpl{
+		db:               b.db,
+		tokens:           b.tokens,
+		refreshTTL:       b.refreshTTL,
+		impersonationTTL: b.impersonationTTL,
+		policy:           b.policy,
+		throttle:         loginThrottle{db: b.db, policy: b.throttle},
+	}
+	if b.oidc != nil {
+		s.oidc = newOIDCProvider(*b.oidc)
+	}
+	return s
 }
 
 // Login implements AuthService
@@ -47,43 +140,753 @@ func (s *authServiceImpl) Login(ctx context.Context, req *api.LoginRequest) (api
 	default:
 	}
 
+	ip := ClientIPFromContext(ctx)
+	attempt := models.LoginEvent{Email: req.Email, Method: string(api.LoginMethodPassword)}
+	if err := s.throttle.check(ctx, req.Email, ip); err != nil {
+		return nil, fmt.Errorf("login: %w", recordLoginFailure(ctx, s.db, attempt, err))
+	}
+
 	var user models.User
 	if err := s.db.WithContext(ctx).Where("email = ?", req.Email).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return &api.ErrorResponse{Message: ErrInvalidCredentials.Error()}, nil
+			return s.loginFailed(ctx, attempt, ip)
 		}
 		return nil, fmt.Errorf("query user: %w", err)
 	}
+	attempt.UserID = &user.ID
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
-		return &api.ErrorResponse{Message: ErrInvalidCredentials.Error()}, nil
+		return s.loginFailed(ctx, attempt, ip)
 	}
 
-	// Generate token expiry (24 hours from now)
-	exp := int(time.Now().Add(24 * time.Hour).Unix())
+	// Only reveal the account status to callers who know the password
+	if err := checkLoginStatus(user.Status); err != nil {
+		return nil, fmt.Errorf("login %s: %w", user.ID, recordLoginFailure(ctx, s.db, attempt, err))
+	}
 
-	return &api.LoginResponse{
-		User: api.AuthUser{
-			AccountNo: user.ID.String(),
-			Email:     user.Email,
-			Role:      []string{user.Role},
-			Exp:       exp,
-		},
-		AccessToken: generateAccessToken(user.ID.String(), exp),
+	// The plaintext is only available now, so outdated hashes are upgraded here
+	if s.policy.needsRehash(user.Password) {
+		hashed, err := s.policy.hash(req.Password)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.db.WithContext(ctx).Model(&user).Update("password", hashed).Error; err != nil {
+			return nil, fmt.Errorf("rehash password: %w", err)
+		}
+	}
+
+	// With MFA enabled the password only opens the second step
+	enabled, err := mfaEnabled(s.db.WithContext(ctx), user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if enabled {
+		attempt.Outcome = string(api.LoginOutcomeMfaRequired)
+		if err := recordLogin(ctx, s.db, attempt); err != nil {
+			return nil, err
+		}
+		return createMFAChallenge(s.db.WithContext(ctx), user.ID)
+	}
+
+	return s.startSession(ctx, user, api.LoginMethodPassword)
+}
+
+// loginFailed records a failed attempt and returns the invalid credentials response
+func (s *authServiceImpl) loginFailed(ctx context.Context, attempt models.LoginEvent, ip string) (api.LoginRes, error) {
+	if err := s.throttle.recordFailure(ctx, attempt.Email, ip); err != nil {
+		return nil, err
+	}
+	if err := recordLoginFailure(ctx, s.db, attempt, ErrInvalidCredentials); !errors.Is(err, ErrInvalidCredentials) {
+		return nil, err
+	}
+	return &api.LoginUnauthorized{Message: ErrInvalidCredentials.Error()}, nil
+}
+
+// VerifyMFA implements AuthService
+func (s *authServiceImpl) VerifyMFA(ctx context.Context, req *api.VerifyMfaRequest) (api.VerifyMfaRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var (
+		user   models.User
+		failed bool
+	)
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var challenge models.MFAChallenge
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_hash = ? AND used_at IS NULL AND expires_at > ?", hashSecret(req.MfaToken), time.Now()).
+			First(&challenge).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrMFAFailed
+			}
+			return fmt.Errorf("get mfa challenge: %w", err)
+		}
+
+		if err := tx.Where("id = ?", challenge.UserID).First(&user).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrMFAFailed
+			}
+			return fmt.Errorf("get user: %w", err)
+		}
+		// The account may have been suspended since the password step
+		if err := checkLoginStatus(user.Status); err != nil {
+			return err
+		}
+		if err := s.throttle.check(ctx, user.Email, ClientIPFromContext(ctx)); err != nil {
+			return err
+		}
+
+		ok, err := checkMFACode(tx, user.ID, req.Code.Or(""), req.RecoveryCode.Or(""))
+		if err != nil {
+			return err
+		}
+		if ok {
+			return tx.Model(&challenge).Update("used_at", time.Now()).Error
+		}
+
+		// Keep the failed attempt; too many of them burn the challenge
+		failed = true
+		updates := map[string]interface{}{"attempts": challenge.Attempts + 1}
+		if challenge.Attempts+1 >= maxMFAChallengeAttempts {
+			updates["used_at"] = time.Now()
+		}
+		if err := tx.Model(&challenge).Updates(updates).Error; err != nil {
+			return fmt.Errorf("record mfa attempt: %w", err)
+		}
+		return nil
+	})
+	attempt := models.LoginEvent{UserID: &user.ID, Email: user.Email, Method: string(api.LoginMethodMfa)}
+	if err != nil {
+		// Unknown challenges are not attributed to any account
+		if user.ID != uuid.Nil {
+			err = recordLoginFailure(ctx, s.db, attempt, err)
+		}
+		return nil, fmt.Errorf("verify mfa: %w", err)
+	}
+	if failed {
+		// Wrong codes count towards the account lockout like wrong passwords
+		if err := s.throttle.recordFailure(ctx, user.Email, ClientIPFromContext(ctx)); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("verify mfa for %s: %w", user.ID, recordLoginFailure(ctx, s.db, attempt, ErrMFAFailed))
+	}
+
+	return s.startSession(ctx, user, api.LoginMethodMfa)
+}
+
+// StartSSO implements AuthService
+func (s *authServiceImpl) StartSSO(ctx context.Context) (api.StartSsoRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	if s.oidc == nil {
+		return nil, ErrSSONotConfigured
+	}
+
+	var secrets [3]string
+	for i := range secrets {
+		secret, err := generateSecret(32)
+		if err != nil {
+			return nil, err
+		}
+		secrets[i] = secret
+	}
+	state, nonce, verifier := secrets[0], secrets[1], secrets[2]
+
+	authURL, err := s.oidc.authorizationURL(ctx, state, nonce, verifier)
+	if err != nil {
+		return nil, fmt.Errorf("start sso: %w", err)
+	}
+
+	pending := &models.SSOState{
+		StateHash:    hashSecret(state),
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		ExpiresAt:    time.Now().Add(oidcStateTTL),
+	}
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("expires_at <= ?", time.Now()).Delete(&models.SSOState{}).Error; err != nil {
+			return fmt.Errorf("delete expired sso states: %w", err)
+		}
+		if err := tx.Create(pending).Error; err != nil {
+			return fmt.Errorf("create sso state: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.SsoAuthorization{
+		AuthorizationUrl: *authURL,
+		State:            state,
+		ExpiresAt:        pending.ExpiresAt,
 	}, nil
 }
 
+// CompleteSSO implements AuthService
+func (s *authServiceImpl) CompleteSSO(ctx context.Context, req *api.SsoCallbackRequest) (api.CompleteSsoRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	if s.oidc == nil {
+		return nil, ErrSSONotConfigured
+	}
+
+	// Deleting the state consumes it, so each authorization response is used once
+	var pending []models.SSOState
+	if err := s.db.WithContext(ctx).Clauses(clause.Returning{}).
+		Where("state_hash = ? AND expires_at > ?", hashSecret(req.State), time.Now()).
+		Delete(&pending).Error; err != nil {
+		return nil, fmt.Errorf("consume sso state: %w", err)
+	}
+	if len(pending) == 0 {
+		return nil, fmt.Errorf("unknown or expired sso state: %w", ErrSSOFailed)
+	}
+
+	rawIDToken, err := s.oidc.exchange(ctx, req.Code, pending[0].CodeVerifier)
+	if err != nil {
+		return nil, fmt.Errorf("complete sso: %w", err)
+	}
+	claims, err := s.oidc.verifyIDToken(ctx, rawIDToken, pending[0].Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("complete sso: %w", err)
+	}
+
+	attempt := models.LoginEvent{Email: claims.Email, Method: string(api.LoginMethodSSO)}
+	user, err := s.ssoUser(ctx, claims)
+	if err != nil {
+		return nil, fmt.Errorf("complete sso: %w", recordLoginFailure(ctx, s.db, attempt, err))
+	}
+	attempt.UserID = &user.ID
+	if err := checkLoginStatus(user.Status); err != nil {
+		return nil, fmt.Errorf("complete sso for %s: %w", user.ID, recordLoginFailure(ctx, s.db, attempt, err))
+	}
+
+	// The identity provider enforces its own second factor, so local MFA is not asked for
+	return s.startSession(ctx, *user, api.LoginMethodSSO)
+}
+
+// ssoUser finds the account for the identity by email, provisioning one when
+// the provider configuration allows it. The provider must vouch for the email,
+// since it decides which local account the identity signs in to.
+func (s *authServiceImpl) ssoUser(ctx context.Context, claims *oidcClaims) (*models.User, error) {
+	if claims.Email == "" || claims.EmailVerified == nil || !*claims.EmailVerified {
+		return nil, fmt.Errorf("identity %s has no verified email: %w", claims.Subject, ErrSSOFailed)
+	}
+
+	// Deleted users are found too, so their email is not provisioned again
+	find := func() (*models.User, error) {
+		var user models.User
+		if err := s.db.WithContext(ctx).Unscoped().Where("LOWER(email) = LOWER(?)", claims.Email).First(&user).Error; err != nil {
+			return nil, err
+		}
+		if user.DeletedAt.Valid {
+			return nil, fmt.Errorf("identity %s belongs to a deleted user: %w", claims.Email, ErrSSOAccountNotFound)
+		}
+		return &user, nil
+	}
+
+	user, err := find()
+	if err == nil {
+		return user, nil
+	}
+	if errors.Is(err, ErrSSOAccountNotFound) {
+		return nil, err
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("query user: %w", err)
+	}
+	if !s.oidc.config.AutoProvision {
+		return nil, fmt.Errorf("identity %s: %w", claims.Email, ErrSSOAccountNotFound)
+	}
+
+	// Provisioned accounts get no password; they sign in through the provider
+	user = &models.User{
+		FirstName: claims.GivenName,
+		LastName:  claims.FamilyName,
+		Email:     claims.Email,
+		Role:      s.oidc.config.DefaultRole,
+		Status:    "active",
+	}
+	if err := insertUser(s.db.WithContext(ctx), user); err != nil {
+		if !errors.Is(err, ErrDuplicateEmail) {
+			return nil, fmt.Errorf("provision user: %w", err)
+		}
+		// A concurrent login may have provisioned the same identity
+		if existing, err := find(); err == nil {
+			return existing, nil
+		}
+		return nil, fmt.Errorf("provision user: %w", err)
+	}
+	return user, nil
+}
+
 // Logout implements AuthService
 func (s *authServiceImpl) Logout(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return ErrUnauthorized
+	}
+
+	// Logging out of an impersonation ends it like stopping it does
+	if principal.ImpersonatorID != uuid.Nil {
+		return s.endImpersonation(ctx, principal)
+	}
+
+	return revokeSession(ctx, s.db, principal.TokenID)
+}
+
+// Impersonate implements AuthService
+func (s *authServiceImpl) Impersonate(ctx context.Context, req *api.ImpersonateRequest, params api.ImpersonateUserParams) (api.ImpersonateUserRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+	if s.tokens == nil {
+		return nil, ErrMissingRequired
+	}
+
+	userID, err := uuid.Parse(params.UserId)
+	if err != nil {
+		return &api.ImpersonateUserNotFound{}, nil
+	}
+	var user models.User
+	if err := s.db.WithContext(ctx).Where("id = ?", userID).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.ImpersonateUserNotFound{}, nil
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	// Acting as oneself or another superadmin would not show a restricted view
+	if user.ID == principal.UserID || user.Role == RoleSuperadmin {
+		return nil, fmt.Errorf("impersonate %s user %s: %w", user.Role, user.ID, ErrCannotImpersonate)
+	}
+	if err := checkLoginStatus(user.Status); err != nil {
+		return nil, fmt.Errorf("impersonate %s user %s: %w", user.Status, user.ID, ErrCannotImpersonate)
+	}
+
+	var impersonator models.User
+	if err := s.db.WithContext(ctx).Where("id = ?", principal.UserID).First(&impersonator).Error; err != nil {
+		return nil, fmt.Errorf("get impersonator: %w", err)
+	}
+
+	var response *api.LoginResponse
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		sessionID, err := randomTokenID()
+		if err != nil {
+			return err
+		}
+		// The session gets no refresh token, so it ends when the token expires
+		session := &models.Session{
+			ID:             sessionID,
+			UserID:         user.ID,
+			ImpersonatorID: &impersonator.ID,
+			ExpiresAt:      time.Now().Add(s.impersonationTTL),
+		}
+		if err := tx.Create(session).Error; err != nil {
+			return fmt.Errorf("create session: %w", err)
+		}
+
+		accessToken, claims, err := s.tokens.Issue(TokenClaims{
+			ID:        session.ID,
+			UserID:    user.ID,
+			Role:      user.Role,
+			ExpiresAt: session.ExpiresAt,
+		})
+		if err != nil {
+			return fmt.Errorf("issue access token: %w", err)
+		}
+
+		if err := recordImpersonation(ctx, tx, impersonator.ID, user.ID, session.ID, impersonationStarted, req.Reason); err != nil {
+			return err
+		}
+
+		response = &api.LoginResponse{
+			User: api.AuthUser{
+				AccountNo: user.ID.String(),
+				Email:     user.Email,
+				Role:      []string{user.Role},
+				Exp:       int(claims.ExpiresAt.Unix()),
+				Impersonator: api.NewOptImpersonator(api.Impersonator{
+					AccountNo: impersonator.ID.String(),
+					Email:     impersonator.Email,
+				}),
+			},
+			AccessToken: accessToken,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("impersonate user: %w", err)
+	}
+
+	return response, nil
+}
+
+// StopImpersonation implements AuthService
+func (s *authServiceImpl) StopImpersonation(ctx context.Context) (api.StopImpersonationRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+	if principal.ImpersonatorID == uuid.Nil {
+		return nil, ErrNotImpersonating
+	}
+
+	if err := s.endImpersonation(ctx, principal); err != nil {
+		return nil, err
+	}
+
+	return &api.StopImpersonationNoContent{}, nil
+}
+
+// endImpersonation revokes the impersonation session and records that it stopped
+func (s *authServiceImpl) endImpersonation(ctx context.Context, principal *Principal) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := revokeSession(ctx, tx, principal.TokenID); err != nil {
+			return err
+		}
+		return recordImpersonation(ctx, tx, principal.ImpersonatorID, principal.UserID, principal.TokenID, impersonationStopped, "")
+	})
+}
+
+// recordImpersonation appends an event to the impersonation audit trail
+func recordImpersonation(ctx context.Context, tx *gorm.DB, impersonatorID, userID uuid.UUID, sessionID, action, reason string) error {
+	event := &models.ImpersonationEvent{
+		ImpersonatorID: impersonatorID,
+		UserID:         userID,
+		SessionID:      sessionID,
+		Action:         action,
+		Reason:         reason,
+		IPAddress:      ClientIPFromContext(ctx),
+	}
+	if err := tx.WithContext(ctx).Create(event).Error; err != nil {
+		return fmt.Errorf("record impersonation %s: %w", action, err)
+	}
 	return nil
 }
 
+// Refresh implements AuthService
+func (s *authServiceImpl) Refresh(ctx context.Context, req *api.RefreshTokenRequest) (api.RefreshTokenRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	if s.tokens == nil {
+		return nil, ErrMissingRequired
+	}
+
+	now := time.Now()
+	var (
+		response *api.LoginResponse
+		reused   *models.RefreshToken
+	)
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current models.RefreshToken
+		if err := tx.Where("token_hash = ?", hashSecret(req.RefreshToken)).First(&current).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidRefreshToken
+			}
+			return fmt.Errorf("get refresh token: %w", err)
+		}
+
+		// Mark the token used with a conditional update so that concurrent
+		// presentations of the same token are also detected as reuse
+		result := tx.Model(&models.RefreshToken{}).
+			Where("id = ? AND used_at IS NULL", current.ID).
+			Update("used_at", now)
+		if result.Error != nil {
+			return fmt.Errorf("mark refresh token used: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			// Reuse of a rotated token means it leaked: revoke the whole family
+			reused = &current
+			return revokeSession(ctx, tx, current.SessionID)
+		}
+
+		if !now.Before(current.ExpiresAt) {
+			return fmt.Errorf("refresh token expired: %w", ErrInvalidRefreshToken)
+		}
+
+		var session models.Session
+		if err := tx.Where("id = ?", current.SessionID).First(&session).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("session revoked: %w", ErrInvalidRefreshToken)
+			}
+			return fmt.Errorf("get session: %w", err)
+		}
+
+		var user models.User
+		if err := tx.Where("id = ?", current.UserID).First(&user).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("user deleted: %w", ErrInvalidRefreshToken)
+			}
+			return fmt.Errorf("get user: %w", err)
+		}
+		if user.Status != "active" {
+			return fmt.Errorf("user %s: %w", user.Status, ErrInvalidRefreshToken)
+		}
+
+		var err error
+		response, err = s.issueTokens(ctx, tx, user, session.ID)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("refresh token: %w", err)
+	}
+	if reused != nil {
+		return nil, fmt.Errorf("session %s: %w", reused.SessionID, ErrRefreshTokenReused)
+	}
+
+	return response, nil
+}
+
 // GetCurrentUser implements AuthService
 func (s *authServiceImpl) GetCurrentUser(ctx context.Context) (api.GetCurrentUserRes, error) {
-	return &api.GetCurrentUserUnauthorized{}, nil
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return &api.GetCurrentUserUnauthorized{}, nil
+	}
+
+	var user models.User
+	if err := s.db.WithContext(ctx).Where("id = ?", principal.UserID).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.GetCurrentUserUnauthorized{}, nil
+		}
+		return nil, fmt.Errorf("get current user: %w", err)
+	}
+
+	// Suspended or otherwise deactivated accounts lose access immediately
+	if user.Status != "active" {
+		return &api.GetCurrentUserUnauthorized{}, nil
+	}
+
+	result := &api.AuthUser{
+		AccountNo: user.ID.String(),
+		Email:     user.Email,
+		Role:      []string{user.Role},
+		Exp:       int(principal.ExpiresAt.Unix()),
+	}
+	if principal.ImpersonatorID != uuid.Nil {
+		var impersonator models.User
+		if err := s.db.WithContext(ctx).Where("id = ?", principal.ImpersonatorID).First(&impersonator).Error; err != nil {
+			return nil, fmt.Errorf("get impersonator: %w", err)
+		}
+		result.Impersonator = api.NewOptImpersonator(api.Impersonator{
+			AccountNo: impersonator.ID.String(),
+			Email:     impersonator.Email,
+		})
+	}
+
+	return result, nil
+}
+
+// Authenticate implements AuthService
+func (s *authServiceImpl) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	// API keys are told apart from signed access tokens by their prefix
+	if strings.HasPrefix(token, apiKeyPrefix) {
+		return authenticateAPIKey(ctx, s.db, token)
+	}
+
+	if s.tokens == nil {
+		return nil, ErrMissingRequired
+	}
+	claims, err := s.tokens.Verify(token)
+	if err != nil {
+		return nil, fmt.Errorf("verify access token: %w: %w", ErrUnauthorized, err)
+	}
+
+	// The token is only valid while its session exists (deleted on logout or revocation)
+	var session models.Session
+	if err := s.db.WithContext(ctx).
+		Where("id = ? AND user_id = ? AND expires_at > ?", claims.ID, claims.UserID, time.Now()).
+		First(&session).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("session %s: %w", claims.ID, ErrUnauthorized)
+		}
+		return nil, fmt.Errorf("get session: %w", err)
+	}
+
+	// Take the role from the user row so role changes apply immediately
+	var user models.User
+	if err := s.db.WithContext(ctx).Select("id", "role", "status").Where("id = ?", claims.UserID).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("user %s: %w", claims.UserID, ErrUnauthorized)
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+	if user.Status != "active" {
+		return nil, fmt.Errorf("user %s is %s: %w", user.ID, user.Status, ErrUnauthorized)
+	}
+
+	principal := &Principal{
+		UserID:    claims.UserID,
+		Role:      user.Role,
+		TokenID:   claims.ID,
+		ExpiresAt: claims.ExpiresAt,
+	}
+
+	// An impersonation ends as soon as the impersonator loses the right to it
+	if session.ImpersonatorID != nil {
+		var impersonator models.User
+		if err := s.db.WithContext(ctx).Select("id", "role", "status").Where("id = ?", *session.ImpersonatorID).First(&impersonator).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, fmt.Errorf("impersonator %s: %w", *session.ImpersonatorID, ErrUnauthorized)
+			}
+			return nil, fmt.Errorf("get impersonator: %w", err)
+		}
+		if impersonator.Status != "active" || !IsOperationAllowed(api.ImpersonateUserOperation, impersonator.Role) {
+			return nil, fmt.Errorf("impersonator %s may no longer impersonate: %w", impersonator.ID, ErrUnauthorized)
+		}
+		principal.ImpersonatorID = impersonator.ID
+	}
+
+	return principal, nil
+}
+
+// checkLoginStatus returns the error for account statuses that may not log in
+func checkLoginStatus(status string) error {
+	switch status {
+	case string(api.UserStatusActive):
+		return nil
+	case string(api.UserStatusSuspended):
+		return ErrAccountSuspended
+	case string(api.UserStatusInvited):
+		return ErrInvitationPending
+	default:
+		return ErrAccountInactive
+	}
+}
+
+// startSession creates a new session for the user, records the successful
+// login and issues the session's first token pair
+func (s *authServiceImpl) startSession(ctx context.Context, user models.User, method api.LoginMethod) (*api.LoginResponse, error) {
+	if s.tokens == nil {
+		return nil, ErrMissingRequired
+	}
+
+	var response *api.LoginResponse
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ? AND expires_at <= ?", user.ID, time.Now()).
+			Delete(&models.Session{}).Error; err != nil {
+			return fmt.Errorf("delete expired sessions: %w", err)
+		}
+
+		sessionID, err := randomTokenID()
+		if err != nil {
+			return err
+		}
+		session := &models.Session{
+			ID:        sessionID,
+			UserID:    user.ID,
+			ExpiresAt: time.Now().Add(s.refreshTTL),
+		}
+		if err := tx.Create(session).Error; err != nil {
+			return fmt.Errorf("create session: %w", err)
+		}
+		// Failures are only forgiven after every factor has passed, so a known
+		// password cannot be used to reset the count of wrong MFA codes
+		if err := resetAccountThrottle(tx, user.Email); err != nil {
+			return err
+		}
+		if err := recordLogin(ctx, tx, models.LoginEvent{
+			UserID:  &user.ID,
+			Email:   user.Email,
+			Method:  string(method),
+			Outcome: string(api.LoginOutcomeSuccess),
+		}); err != nil {
+			return err
+		}
+
+		response, err = s.issueTokens(ctx, tx, user, session.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
-// generateAccessToken generates a simple access token
-func generateAccessToken(userID string, exp int) string {
-	return fmt.Sprintf("token_%s_%d", userID, exp)
+// issueTokens issues an access token for the session together with a new
+// refresh token, and extends the session to the refresh token's lifetime
+func (s *authServiceImpl) issueTokens(ctx context.Context, tx *gorm.DB, user models.User, sessionID string) (*api.LoginResponse, error) {
+	accessToken, claims, err := s.tokens.Issue(TokenClaims{ID: sessionID, UserID: user.ID, Role: user.Role})
+	if err != nil {
+		return nil, fmt.Errorf("issue access token: %w", err)
+	}
+
+	secret, err := generateSecret(32)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().Add(s.refreshTTL)
+	refresh := &models.RefreshToken{
+		SessionID: sessionID,
+		UserID:    user.ID,
+		TokenHash: hashSecret(secret),
+		ExpiresAt: expiresAt,
+	}
+	if err := tx.WithContext(ctx).Create(refresh).Error; err != nil {
+		return nil, fmt.Errorf("create refresh token: %w", err)
+	}
+
+	if err := tx.WithContext(ctx).Model(&models.Session{}).
+		Where("id = ?", sessionID).
+		Update("expires_at", expiresAt).Error; err != nil {
+		return nil, fmt.Errorf("extend session: %w", err)
+	}
+
+	return &api.LoginResponse{
+		User: api.AuthUser{
+			AccountNo: user.ID.String(),
+			Email:     user.Email,
+			Role:      []string{user.Role},
+			Exp:       int(claims.ExpiresAt.Unix()),
+		},
+		AccessToken:  accessToken,
+		RefreshToken: api.NewOptString(secret),
+	}, nil
 }
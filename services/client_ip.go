@@ -0,0 +1,31 @@
+package services
+
+import "context"
+
+// clientIPContextKey is the context key for the caller's IP address
+type clientIPContextKey struct{}
+
+// ContextWithClientIP returns a copy of ctx carrying the caller's IP address
+func ContextWithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPContextKey{}, ip)
+}
+
+// ClientIPFromContext returns the caller's IP address, or "" when unknown
+func ClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPContextKey{}).(string)
+	return ip
+}
+
+// userAgentContextKey is the context key for the caller's User-Agent header
+type userAgentContextKey struct{}
+
+// ContextWithUserAgent returns a copy of ctx carrying the caller's User-Agent
+func ContextWithUserAgent(ctx context.Context, userAgent string) context.Context {
+	return context.WithValue(ctx, userAgentContextKey{}, userAgent)
+}
+
+// UserAgentFromContext returns the caller's User-Agent, or "" when unknown
+func UserAgentFromContext(ctx context.Context) string {
+	userAgent, _ := ctx.Value(userAgentContextKey{}).(string)
+	return userAgent
+}
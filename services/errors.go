@@ -9,7 +9,44 @@ var (
 	ErrAppNotFound         = errors.New("app not found")
 	ErrChatNotFound        = errors.New("chat not found")
 	ErrInvalidCredentials  = errors.New("invalid credentials")
+	ErrAccountSuspended    = errors.New("account suspended")
+	ErrAccountInactive     = errors.New("account inactive")
+	ErrInvitationPending   = errors.New("invitation pending")
 	ErrUnauthorized        = errors.New("unauthorized")
+	ErrForbidden           = errors.New("forbidden")
 	ErrDuplicateEmail      = errors.New("email already exists")
 	ErrDuplicateUsername   = errors.New("username already exists")
+	ErrUserNotInvited      = errors.New("user has no pending invitation")
+	ErrInvalidToken        = errors.New("invalid token")
+	ErrTokenExpired        = errors.New("token expired")
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token reused")
+	ErrInvalidResetToken   = errors.New("invalid password reset token")
+	ErrIncorrectPassword   = errors.New("incorrect password")
+	ErrPasswordPolicy      = errors.New("password violates policy")
+	ErrTooManyAttempts     = errors.New("too many attempts")
+	ErrMFAAlreadyEnabled   = errors.New("mfa already enabled")
+	ErrMFANotEnrolled      = errors.New("mfa enrolment not started")
+	ErrInvalidMFACode      = errors.New("invalid mfa code")
+	ErrMFAFailed           = errors.New("mfa verification failed")
+	ErrSSONotConfigured    = errors.New("single sign-on not configured")
+	ErrSSOFailed           = errors.New("single sign-on failed")
+	ErrSSOAccountNotFound  = errors.New("no account for single sign-on identity")
+	ErrInvalidAPIKeyExpiry = errors.New("api key expiry must be in the future")
+	ErrCannotImpersonate   = errors.New("user cannot be impersonated")
+	ErrNotImpersonating    = errors.New("not impersonating")
+	ErrInvalidSort         = errors.New("invalid sort")
+	ErrInvalidCursor       = errors.New("invalid cursor")
+	ErrInvalidImport       = errors.New("invalid import file")
+	ErrUserNotDeleted      = errors.New("user not deleted")
+	ErrInvalidBulkAction   = errors.New("invalid bulk action")
+	ErrLastSuperadmin      = errors.New("last active superadmin")
+	ErrPreconditionFailed  = errors.New("precondition failed")
 )
+
+// FieldViolation describes why a request field was rejected
+type FieldViolation struct {
+	Field   string
+	Code    string
+	Message string
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"gorm.io/gorm"
+)
+
+// versionETag returns the ETag of a record version
+func versionETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// ifMatchVersions parses an If-Match header into the record versions it
+// accepts. conditional is false when the header is absent or "*".
+func ifMatchVersions(ifMatch api.OptString) (versions []int, conditional bool) {
+	header, ok := ifMatch.Get()
+	if !ok || strings.TrimSpace(header) == "*" {
+		return nil, false
+	}
+	for _, tag := range strings.Split(header, ",") {
+		// If-Match uses strong comparison, so weak tags never match
+		tag = strings.TrimSpace(tag)
+		if len(tag) < 2 || !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) {
+			continue
+		}
+		if v, err := strconv.Atoi(tag[1 : len(tag)-1]); err == nil {
+			versions = append(versions, v)
+		}
+	}
+	return versions, true
+}
+
+// ifMatchAllows reports whether a change to the given version satisfies If-Match
+func ifMatchAllows(ifMatch api.OptString, version int) bool {
+	versions, conditional := ifMatchVersions(ifMatch)
+	return !conditional || slices.Contains(versions, version)
+}
+
+// whereIfMatch restricts a write to the versions If-Match accepts, so a record
+// changed since it was read is left alone
+func whereIfMatch(query *gorm.DB, ifMatch api.OptString) *gorm.DB {
+	versions, conditional := ifMatchVersions(ifMatch)
+	if !conditional {
+		return query
+	}
+	if len(versions) == 0 {
+		return query.Where("FALSE")
+	}
+	return query.Where("version IN ?", versions)
+}
@@ -0,0 +1,323 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+	"gorm.io/gorm"
+)
+
+// defaultInvitationTTL is how long an invitation link stays valid
+const defaultInvitationTTL = 7 * 24 * time.Hour
+
+// defaultAppURL is the frontend base URL used in emailed links
+const defaultAppURL = "http://localhost:5173"
+
+// InvitationService interface for the invitation onboarding flow
+type InvitationService interface {
+	Get(ctx context.Context, params api.GetInvitationParams) (api.GetInvitationRes, error)
+	Accept(ctx context.Context, req *api.AcceptInvitationRequest, params api.AcceptInvitationParams) (api.AcceptInvitationRes, error)
+	Resend(ctx context.Context, params api.ResendInvitationParams) (api.ResendInvitationRes, error)
+	Revoke(ctx context.Context, params api.RevokeInvitationParams) (api.RevokeInvitationRes, error)
+}
+
+// invitationServiceImpl implements InvitationService
+type invitationServiceImpl struct {
+	db     *gorm.DB
+	policy PasswordPolicy
+	sender invitationSender
+}
+
+// invitationServiceBuilder is the builder for InvitationService
+type invitationServiceBuilder struct {
+	db     *gorm.DB
+	policy PasswordPolicy
+	mailer Mailer
+	appURL string
+}
+
+// NewInvitationService creates a new InvitationService builder
+func NewInvitationService(db *gorm.DB) *invitationServiceBuilder {
+	return &invitationServiceBuilder{db: db, policy: DefaultPasswordPolicy(), mailer: unconfiguredMailer{}, appURL: defaultAppURL}
+}
+
+// WithPasswordPolicy sets the rules the invitee's password must satisfy
+func (b *invitationServiceBuilder) WithPasswordPolicy(policy PasswordPolicy) *invitationServiceBuilder {
+	b.policy = policy
+	return b
+}
+
+// WithMailer sets the mailer used to deliver invitation links. Without
+// one, sending fails.
+func (b *invitationServiceBuilder) WithMailer(mailer Mailer) *invitationServiceBuilder {
+	b.mailer = mailer
+	return b
+}
+
+// WithAppURL sets the frontend base URL used in invitation links
+func (b *invitationServiceBuilder) WithAppURL(appURL string) *invitationServiceBuilder {
+	b.appURL = appURL
+	return b
+}
+
+// Build creates the InvitationService
+func (b *invitationServiceBuilder) Build() InvitationService {
+	return &invitationServiceImpl{
+		db:     b.db,
+		policy: b.policy,
+		sender: invitationSender{mailer: b.mailer, appURL: b.appURL, ttl: defaultInvitationTTL},
+	}
+}
+
+// Get implements InvitationService
+func (s *invitationServiceImpl) Get(ctx context.Context, params api.GetInvitationParams) (api.GetInvitationRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	invitation, user, err := findPendingInvitation(s.db.WithContext(ctx), params.Token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.GetInvitationNotFound{}, nil
+		}
+		return nil, err
+	}
+
+	return &api.InvitationDetails{
+		Email:     user.Email,
+		Role:      api.UserRole(user.Role),
+		ExpiresAt: invitation.ExpiresAt,
+	}, nil
+}
+
+// Accept implements InvitationService
+func (s *invitationServiceImpl) Accept(ctx context.Context, req *api.AcceptInvitationRequest, params api.AcceptInvitationParams) (api.AcceptInvitationRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var user *models.User
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		invitation, invited, err := findPendingInvitation(tx, params.Token)
+		if err != nil {
+			return err
+		}
+
+		// Conditional update keeps the token single-use under concurrent accepts
+		result := tx.Model(&models.Invitation{}).
+			Where("id = ? AND accepted_at IS NULL AND revoked_at IS NULL", invitation.ID).
+			Update("accepted_at", time.Now())
+		if result.Error != nil {
+			return fmt.Errorf("accept invitation: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		// A rejected password rolls back, leaving the invitation open for another try
+		if err := s.policy.setPassword(tx, *invited, "password", req.Password); err != nil {
+			return err
+		}
+		if err := tx.Model(invited).Updates(map[string]interface{}{
+			"first_name": req.FirstName,
+			"last_name":  req.LastName,
+			"status":     string(api.UserStatusActive),
+			"version":    gorm.Expr("version + 1"),
+		}).Error; err != nil {
+			return fmt.Errorf("activate user: %w", err)
+		}
+
+		user = invited
+		return tx.First(user, "id = ?", invited.ID).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.AcceptInvitationNotFound{}, nil
+		}
+		return nil, err
+	}
+
+	result := userToAPI(*user)
+	return &result, nil
+}
+
+// Resend implements InvitationService
+func (s *invitationServiceImpl) Resend(ctx context.Context, params api.ResendInvitationParams) (api.ResendInvitationRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	user, err := s.findInvitedUser(ctx, params.UserId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.ResendInvitationNotFound{}, nil
+		}
+		return nil, err
+	}
+	if err := authorizeUserChange(ctx, *user); err != nil {
+		return nil, err
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return s.sender.send(ctx, tx, *user)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ResendInvitationNoContent{}, nil
+}
+
+// Revoke implements InvitationService
+func (s *invitationServiceImpl) Revoke(ctx context.Context, params api.RevokeInvitationParams) (api.RevokeInvitationRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	user, err := s.findInvitedUser(ctx, params.UserId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.RevokeInvitationNotFound{}, nil
+		}
+		return nil, err
+	}
+	if err := authorizeUserChange(ctx, *user); err != nil {
+		return nil, err
+	}
+
+	// The invited account was never used, so it is removed to free the email
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := revokePendingInvitations(tx, user.ID); err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Delete(&models.User{}, "id = ?", user.ID).Error; err != nil {
+			return fmt.Errorf("delete invited user: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.RevokeInvitationNoContent{}, nil
+}
+
+// findInvitedUser loads a user that must still be in the invited status
+func (s *invitationServiceImpl) findInvitedUser(ctx context.Context, userID string) (*models.User, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	var user models.User
+	if err := s.db.WithContext(ctx).Where("id = ?", id).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+	if user.Status != string(api.UserStatusInvited) {
+		return nil, fmt.Errorf("user %s is %s: %w", user.ID, user.Status, ErrUserNotInvited)
+	}
+	return &user, nil
+}
+
+// findPendingInvitation looks up an unexpired, unused invitation by its token
+// and the invited user it belongs to. It returns gorm.ErrRecordNotFound when
+// either is missing.
+func findPendingInvitation(db *gorm.DB, token string) (*models.Invitation, *models.User, error) {
+	var invitation models.Invitation
+	if err := db.Where("token_hash = ? AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at > ?",
+		hashSecret(token), time.Now()).First(&invitation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("get invitation: %w", err)
+	}
+
+	var user models.User
+	if err := db.Where("id = ? AND status = ?", invitation.UserID, string(api.UserStatusInvited)).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("get invited user: %w", err)
+	}
+
+	return &invitation, &user, nil
+}
+
+// revokePendingInvitations marks all of the user's open invitations revoked
+func revokePendingInvitations(tx *gorm.DB, userID uuid.UUID) error {
+	if err := tx.Model(&models.Invitation{}).
+		Where("user_id = ? AND accepted_at IS NULL AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).Error; err != nil {
+		return fmt.Errorf("revoke invitations: %w", err)
+	}
+	return nil
+}
+
+// invitationSender issues invitation tokens and mails the acceptance link
+type invitationSender struct {
+	mailer Mailer
+	appURL string
+	ttl    time.Duration
+}
+
+// send replaces the user's pending invitations with a new one and mails its link.
+// It runs inside the caller's transaction so a failed delivery rolls back.
+func (s invitationSender) send(ctx context.Context, tx *gorm.DB, user models.User) error {
+	msg, err := s.prepare(tx, user)
+	if err != nil {
+		return err
+	}
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		return fmt.Errorf("send invitation: %w", err)
+	}
+	return nil
+}
+
+// prepare replaces the user's pending invitations with a new one and returns
+// the message carrying its link, for callers that send it themselves
+func (s invitationSender) prepare(tx *gorm.DB, user models.User) (MailMessage, error) {
+	if err := revokePendingInvitations(tx, user.ID); err != nil {
+		return MailMessage{}, err
+	}
+
+	token, err := generateSecret(32)
+	if err != nil {
+		return MailMessage{}, err
+	}
+	invitation := &models.Invitation{
+		UserID:    user.ID,
+		TokenHash: hashSecret(token),
+		ExpiresAt: time.Now().Add(s.ttl),
+	}
+	if err := tx.Create(invitation).Error; err != nil {
+		return MailMessage{}, fmt.Errorf("create invitation: %w", err)
+	}
+
+	link := strings.TrimRight(s.appURL, "/") + "/accept-invitation?token=" + url.QueryEscape(token)
+	return MailMessage{
+		To:      user.Email,
+		Subject: "You have been invited to Shadcn Admin",
+		Body: fmt.Sprintf("You have been invited to join Shadcn Admin as %s.\n\n"+
+			"Accept the invitation and choose your password here:\n%s\n\n"+
+			"This link expires on %s.",
+			user.Role, link, invitation.ExpiresAt.UTC().Format(time.RFC1123)),
+	}, nil
+}
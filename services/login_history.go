@@ -0,0 +1,185 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+	"gorm.io/gorm"
+)
+
+// loginFailureReasons maps login errors to the reason stored with a failed attempt
+var loginFailureReasons = []struct {
+	err    error
+	reason string
+}{
+	{ErrInvalidCredentials, "invalid_credentials"},
+	{ErrTooManyAttempts, "too_many_attempts"},
+	{ErrAccountSuspended, "account_suspended"},
+	{ErrAccountInactive, "account_inactive"},
+	{ErrInvitationPending, "invitation_pending"},
+	{ErrMFAFailed, "mfa_failed"},
+	{ErrSSOFailed, "sso_failed"},
+	{ErrSSOAccountNotFound, "sso_account_not_found"},
+}
+
+// loginFailureReason returns the stored reason for a login error, and false for
+// errors that are not a refused login such as database failures
+func loginFailureReason(err error) (string, bool) {
+	for _, r := range loginFailureReasons {
+		if errors.Is(err, r.err) {
+			return r.reason, true
+		}
+	}
+	return "", false
+}
+
+// recordLogin stores a login attempt made from the request in ctx. The account
+// is looked up by email when the caller has not resolved it.
+func recordLogin(ctx context.Context, db *gorm.DB, event models.LoginEvent) error {
+	if event.UserID == nil && event.Email != "" {
+		var user models.User
+		err := db.WithContext(ctx).Select("id").Where("email = ?", event.Email).First(&user).Error
+		switch {
+		case err == nil:
+			event.UserID = &user.ID
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return fmt.Errorf("get user: %w", err)
+		}
+	}
+	event.IPAddress = ClientIPFromContext(ctx)
+	event.UserAgent = UserAgentFromContext(ctx)
+
+	if err := db.WithContext(ctx).Create(&event).Error; err != nil {
+		return fmt.Errorf("record login: %w", err)
+	}
+	return nil
+}
+
+// recordLoginFailure stores a refused login and returns err unchanged, so
+// callers can record and return in one step. Of the attempts refused while
+// throttled, only the first of each block is stored.
+func recordLoginFailure(ctx context.Context, db *gorm.DB, event models.LoginEvent, err error) error {
+	reason, ok := loginFailureReason(err)
+	if !ok {
+		return err
+	}
+	var tooMany *TooManyAttemptsError
+	if errors.As(err, &tooMany) && !tooMany.first {
+		return err
+	}
+	event.Outcome = string(api.LoginOutcomeFailure)
+	event.FailureReason = reason
+	if recordErr := recordLogin(ctx, db, event); recordErr != nil {
+		return recordErr
+	}
+	return err
+}
+
+// LoginHistoryService interface for reviewing a user's login attempts
+type LoginHistoryService interface {
+	List(ctx context.Context, params api.ListLoginHistoryParams) (api.ListLoginHistoryRes, error)
+}
+
+// loginHistoryServiceImpl implements LoginHistoryService
+type loginHistoryServiceImpl struct {
+	db *gorm.DB
+}
+
+// loginHistoryServiceBuilder is the builder for LoginHistoryService
+type loginHistoryServiceBuilder struct {
+	db *gorm.DB
+}
+
+// NewLoginHistoryService creates a new LoginHistoryService builder
+func NewLoginHistoryService(db *gorm.DB) *loginHistoryServiceBuilder {
+	return &loginHistoryServiceBuilder{db: db}
+}
+
+// Build creates the LoginHistoryService
+func (b *loginHistoryServiceBuilder) Build() LoginHistoryService {
+	return &loginHistoryServiceImpl{db: b.db}
+}
+
+// List implements LoginHistoryService
+func (s *loginHistoryServiceImpl) List(ctx context.Context, params api.ListLoginHistoryParams) (api.ListLoginHistoryRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	userID, err := uuid.Parse(params.UserId)
+	if err != nil {
+		return &api.ListLoginHistoryNotFound{}, nil
+	}
+	if err := s.db.WithContext(ctx).Select("id").Where("id = ?", userID).First(&models.User{}).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.ListLoginHistoryNotFound{}, nil
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	page := params.Page.Or(1)
+	pageSize := params.PageSize.Or(10)
+	offset := (page - 1) * pageSize
+
+	query := s.db.WithContext(ctx).Model(&models.LoginEvent{}).Where("user_id = ?", userID)
+	if outcome, ok := params.Outcome.Get(); ok {
+		query = query.Where("outcome = ?", string(outcome))
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("count login events: %w", err)
+	}
+
+	// Events recorded in the same instant need a stable order to page through
+	var events []models.LoginEvent
+	if err := query.Order("created_at DESC, id DESC").Offset(offset).Limit(pageSize).Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("list login events: %w", err)
+	}
+
+	data := make([]api.LoginEvent, len(events))
+	for i, e := range events {
+		data[i] = loginEventToAPI(e)
+	}
+
+	totalPages := int(total) / pageSize
+	if int(total)%pageSize > 0 {
+		totalPages++
+	}
+
+	return &api.LoginHistoryResponse{
+		Data: data,
+		Meta: api.PaginationMeta{
+			Page:       page,
+			PageSize:   pageSize,
+			Total:      int(total),
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+// loginEventToAPI converts a models.LoginEvent to api.LoginEvent
+func loginEventToAPI(e models.LoginEvent) api.LoginEvent {
+	result := api.LoginEvent{
+		ID:        e.ID,
+		Method:    api.LoginMethod(e.Method),
+		Outcome:   api.LoginOutcome(e.Outcome),
+		CreatedAt: e.CreatedAt,
+	}
+	if e.FailureReason != "" {
+		result.FailureReason = api.NewOptString(e.FailureReason)
+	}
+	if e.IPAddress != "" {
+		result.IpAddress = api.NewOptString(e.IPAddress)
+	}
+	if e.UserAgent != "" {
+		result.UserAgent = api.NewOptString(e.UserAgent)
+	}
+	return result
+}
@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Kinds of login throttle counters
+const (
+	throttleAccount = "account"
+	throttleIP      = "ip"
+)
+
+// LoginThrottlePolicy configures how failed logins slow down further attempts
+// for the same account and the same client IP
+type LoginThrottlePolicy struct {
+	// FreeAttempts is how many consecutive failures are allowed without delay
+	FreeAttempts int
+	// BaseDelay is the wait after the first delayed failure. It doubles with
+	// every further failure, up to MaxDelay.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// AccountLockoutThreshold is the failure count at which an account is
+	// locked for LockoutDuration. Zero disables account lockout.
+	AccountLockoutThreshold int
+	// IPLockoutThreshold is the same for a client IP. It is usually higher
+	// since many users may share an address. Zero disables IP lockout.
+	IPLockoutThreshold int
+	LockoutDuration    time.Duration
+	// FailureWindow is the quiet period after which the failure count restarts
+	FailureWindow time.Duration
+}
+
+// DefaultLoginThrottlePolicy returns the policy used when none is configured
+func DefaultLoginThrottlePolicy() LoginThrottlePolicy {
+	return LoginThrottlePolicy{
+		FreeAttempts:            3,
+		BaseDelay:               time.Second,
+		MaxDelay:                time.Minute,
+		AccountLockoutThreshold: 10,
+		IPLockoutThreshold:      50,
+		LockoutDuration:         15 * time.Minute,
+		FailureWindow:           time.Hour,
+	}
+}
+
+// delay returns how long to block further attempts after the given number of
+// consecutive failures
+func (p LoginThrottlePolicy) delay(failures, lockoutThreshold int) time.Duration {
+	if lockoutThreshold > 0 && failures >= lockoutThreshold {
+		return p.LockoutDuration
+	}
+	over := failures - p.FreeAttempts
+	if over <= 0 {
+		return 0
+	}
+	d := p.BaseDelay
+	for i := 1; i < over && d < p.MaxDelay; i++ {
+		d *= 2
+	}
+	return min(d, p.MaxDelay)
+}
+
+// TooManyAttemptsError is returned while logins are blocked
+type TooManyAttemptsError struct {
+	Wait time.Duration
+	// first is set for the first attempt refused during the block
+	first bool
+}
+
+// Error implements error
+func (e *TooManyAttemptsError) Error() string {
+	return fmt.Sprintf("%s, retry in %s", ErrTooManyAttempts, e.Wait)
+}
+
+// Unwrap lets errors.Is match ErrTooManyAttempts
+func (e *TooManyAttemptsError) Unwrap() error {
+	return ErrTooManyAttempts
+}
+
+// RetryAfter returns how long the caller should wait before trying again
+func (e *TooManyAttemptsError) RetryAfter() time.Duration {
+	return e.Wait
+}
+
+// loginThrottle records failed logins and blocks callers that exceed the policy.
+// Accounts are keyed by normalized email so unknown emails are throttled the
+// same way as registered ones.
+type loginThrottle struct {
+	db     *gorm.DB
+	policy LoginThrottlePolicy
+}
+
+// check returns a TooManyAttemptsError while the account or client IP is blocked
+func (t loginThrottle) check(ctx context.Context, email, ip string) error {
+	now := time.Now()
+	var blocked []models.LoginThrottle
+	if err := t.subjects(t.db.WithContext(ctx), email, ip).
+		Where("blocked_until > ?", now).
+		Find(&blocked).Error; err != nil {
+		return fmt.Errorf("check login throttle: %w", err)
+	}
+
+	var until time.Time
+	for _, b := range blocked {
+		if b.BlockedUntil.After(until) {
+			until = b.BlockedUntil
+		}
+	}
+	if until.IsZero() {
+		return nil
+	}
+
+	// Claiming the block marks the refusal as recorded, so only one attempt
+	// per block reaches the login history however often the caller retries
+	claimed := t.subjects(t.db.WithContext(ctx).Model(&models.LoginThrottle{}), email, ip).
+		Where("blocked_until > ? AND rejection_recorded_until IS DISTINCT FROM blocked_until", now).
+		Update("rejection_recorded_until", gorm.Expr("blocked_until"))
+	if claimed.Error != nil {
+		return fmt.Errorf("claim login throttle: %w", claimed.Error)
+	}
+	return &TooManyAttemptsError{Wait: until.Sub(now), first: claimed.RowsAffected > 0}
+}
+
+// recordFailure counts a failed login against the account and client IP
+func (t loginThrottle) recordFailure(ctx context.Context, email, ip string) error {
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := t.bump(tx, throttleAccount, accountThrottleKey(email), t.policy.AccountLockoutThreshold); err != nil {
+			return err
+		}
+		if ip == "" {
+			return nil
+		}
+		return t.bump(tx, throttleIP, ip, t.policy.IPLockoutThreshold)
+	})
+}
+
+// bump increments one counter and extends its block according to the policy
+func (t loginThrottle) bump(tx *gorm.DB, kind, subject string, lockoutThreshold int) error {
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.LoginThrottle{Kind: kind, Subject: subject}).Error; err != nil {
+		return fmt.Errorf("create login throttle: %w", err)
+	}
+
+	// Row lock serializes concurrent failures for the same subject
+	var counter models.LoginThrottle
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("kind = ? AND subject = ?", kind, subject).
+		First(&counter).Error; err != nil {
+		return fmt.Errorf("get login throttle: %w", err)
+	}
+
+	now := time.Now()
+	if now.Sub(counter.LastFailureAt) > t.policy.FailureWindow {
+		counter.Failures = 0
+	}
+	counter.Failures++
+	counter.LastFailureAt = now
+	if d := t.policy.delay(counter.Failures, lockoutThreshold); d > 0 {
+		counter.BlockedUntil = now.Add(d)
+	}
+
+	if err := tx.Save(&counter).Error; err != nil {
+		return fmt.Errorf("update login throttle: %w", err)
+	}
+	return nil
+}
+
+// subjects scopes a query to the throttle rows of the account and client IP
+func (t loginThrottle) subjects(db *gorm.DB, email, ip string) *gorm.DB {
+	return db.Where("(kind = ? AND subject = ?) OR (kind = ? AND subject = ?)",
+		throttleAccount, accountThrottleKey(email), throttleIP, ip)
+}
+
+// resetAccountThrottle clears the account's failures after a successful login or an admin unlock
+func resetAccountThrottle(db *gorm.DB, email string) error {
+	if err := db.Where("kind = ? AND subject = ?", throttleAccount, accountThrottleKey(email)).
+		Delete(&models.LoginThrottle{}).Error; err != nil {
+		return fmt.Errorf("reset login throttle: %w", err)
+	}
+	return nil
+}
+
+// accountThrottleKey normalizes an email for use as a throttle subject
+func accountThrottleKey(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
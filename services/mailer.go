@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// MailMessage is a plain-text transactional email
+type MailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers transactional email such as invitations
+type Mailer interface {
+	Send(ctx context.Context, msg MailMessage) error
+}
+
+// errNoMailer is returned when mail is sent without a configured Mailer
+var errNoMailer = errors.New("no mailer configured")
+
+// unconfiguredMailer is the default Mailer of the services that send mail. It
+// refuses every message so links are never delivered or logged by accident.
+type unconfiguredMailer struct{}
+
+// Send implements Mailer
+func (unconfiguredMailer) Send(ctx context.Context, msg MailMessage) error {
+	return fmt.Errorf("send %q to %s: %w", msg.Subject, msg.To, errNoMailer)
+}
+
+// logMailer writes messages to the standard logger instead of sending them
+type logMailer struct{}
+
+// NewLogMailer creates a Mailer that logs messages, for local development.
+// Bodies carry sign-in links, so it must never be used in production.
+func NewLogMailer() Mailer {
+	return logMailer{}
+}
+
+// Send implements Mailer
+func (logMailer) Send(ctx context.Context, msg MailMessage) error {
+	log.Printf("mail to=%s subject=%q\n%s", msg.To, msg.Subject, msg.Body)
+	return nil
+}
+
+// SMTPConfig configures delivery through an SMTP server
+type SMTPConfig struct {
+	Host string
+	Port int
+	// Username and Password enable PLAIN authentication when set
+	Username string
+	Password string
+	// From is the sender address, e.g. "Shadcn Admin <no-reply@example.com>"
+	From string
+}
+
+// smtpMailer sends messages through an SMTP server, upgrading to TLS when
+// the server offers STARTTLS
+type smtpMailer struct {
+	config SMTPConfig
+}
+
+// NewSMTPMailer creates a Mailer that delivers through the SMTP server
+func NewSMTPMailer(config SMTPConfig) Mailer {
+	return &smtpMailer{config: config}
+}
+
+// Send implements Mailer
+func (m *smtpMailer) Send(ctx context.Context, msg MailMessage) error {
+	// Header values must not be able to add headers of their own
+	for _, value := range []string{m.config.From, msg.To, msg.Subject} {
+		if strings.ContainsAny(value, "\r\n") {
+			return errors.New("send mail: header contains a line break")
+		}
+	}
+
+	addr := net.JoinHostPort(m.config.Host, fmt.Sprint(m.config.Port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("connect to %s: %w", addr, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, m.config.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("connect to %s: %w", addr, err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: m.config.Host}); err != nil {
+			return fmt.Errorf("start tls: %w", err)
+		}
+	}
+	if m.config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)); err != nil {
+			return fmt.Errorf("authenticate: %w", err)
+		}
+	}
+
+	from := m.config.From
+	if start, end := strings.LastIndex(from, "<"), strings.LastIndex(from, ">"); start >= 0 && end > start {
+		from = from[start+1 : end]
+	}
+	if err := client.Mail(from); err != nil {
+		return fmt.Errorf("send mail from %s: %w", from, err)
+	}
+	if err := client.Rcpt(msg.To); err != nil {
+		return fmt.Errorf("send mail to %s: %w", msg.To, err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("send mail: %w", err)
+	}
+	headers := "From: " + m.config.From + "\r\n" +
+		"To: " + msg.To + "\r\n" +
+		"Subject: " + mime.QEncoding.Encode("utf-8", msg.Subject) + "\r\n" +
+		"Date: " + time.Now().Format(time.RFC1123Z) + "\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=UTF-8\r\n\r\n"
+	body := strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n")
+	if _, err := w.Write([]byte(headers + body)); err != nil {
+		return fmt.Errorf("send mail: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("send mail: %w", err)
+	}
+	return client.Quit()
+}
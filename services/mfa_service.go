@@ -0,0 +1,296 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// defaultMFAIssuer is the account issuer shown in authenticator apps
+const defaultMFAIssuer = "Shadcn Admin"
+
+// mfaChallengeTTL is how long the second login step stays open
+const mfaChallengeTTL = 5 * time.Minute
+
+// maxMFAChallengeAttempts is how many wrong codes burn a challenge
+const maxMFAChallengeAttempts = 5
+
+// recoveryCodeCount is how many recovery codes are issued on confirmation
+const recoveryCodeCount = 10
+
+// MFAService interface for TOTP enrolment and administration
+type MFAService interface {
+	Enroll(ctx context.Context) (api.EnrollMfaRes, error)
+	Confirm(ctx context.Context, req *api.ConfirmMfaRequest) (api.ConfirmMfaRes, error)
+	Reset(ctx context.Context, params api.ResetUserMfaParams) (api.ResetUserMfaRes, error)
+}
+
+// mfaServiceImpl implements MFAService
+type mfaServiceImpl struct {
+	db     *gorm.DB
+	issuer string
+}
+
+// mfaServiceBuilder is the builder for MFAService
+type mfaServiceBuilder struct {
+	db     *gorm.DB
+	issuer string
+}
+
+// NewMFAService creates a new MFAService builder
+func NewMFAService(db *gorm.DB) *mfaServiceBuilder {
+	return &mfaServiceBuilder{db: db, issuer: defaultMFAIssuer}
+}
+
+// WithIssuer sets the issuer name shown in authenticator apps
+func (b *mfaServiceBuilder) WithIssuer(issuer string) *mfaServiceBuilder {
+	b.issuer = issuer
+	return b
+}
+
+// Build creates the MFAService
+func (b *mfaServiceBuilder) Build() MFAService {
+	return &mfaServiceImpl{db: b.db, issuer: b.issuer}
+}
+
+// Enroll implements MFAService
+func (s *mfaServiceImpl) Enroll(ctx context.Context) (api.EnrollMfaRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+
+	var user models.User
+	if err := s.db.WithContext(ctx).Where("id = ?", principal.UserID).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUnauthorized
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing models.UserMFA
+		err := tx.Where("user_id = ?", user.ID).First(&existing).Error
+		switch {
+		case err == nil && existing.ConfirmedAt != nil:
+			return ErrMFAAlreadyEnabled
+		case err != nil && !errors.Is(err, gorm.ErrRecordNotFound):
+			return fmt.Errorf("get mfa: %w", err)
+		}
+
+		// Restarting enrolment replaces the unconfirmed secret
+		enrollment := &models.UserMFA{UserID: user.ID, Secret: secret}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"secret", "last_used_step", "created_at"}),
+		}).Create(enrollment).Error; err != nil {
+			return fmt.Errorf("save mfa enrolment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("enroll mfa: %w", err)
+	}
+
+	return &api.MfaEnrollment{
+		Secret:     secret,
+		OtpauthUri: totpURI(s.issuer, user.Email, secret),
+	}, nil
+}
+
+// Confirm implements MFAService
+func (s *mfaServiceImpl) Confirm(ctx context.Context, req *api.ConfirmMfaRequest) (api.ConfirmMfaRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+
+	var codes []string
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var enrollment models.UserMFA
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ?", principal.UserID).First(&enrollment).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrMFANotEnrolled
+			}
+			return fmt.Errorf("get mfa: %w", err)
+		}
+		if enrollment.ConfirmedAt != nil {
+			return ErrMFAAlreadyEnabled
+		}
+
+		step, ok := verifyTOTP(enrollment.Secret, req.Code, time.Now())
+		if !ok {
+			return ErrInvalidMFACode
+		}
+		if err := tx.Model(&enrollment).Updates(map[string]interface{}{
+			"confirmed_at":   time.Now(),
+			"last_used_step": step,
+		}).Error; err != nil {
+			return fmt.Errorf("confirm mfa: %w", err)
+		}
+
+		var err error
+		codes, err = replaceRecoveryCodes(tx, principal.UserID)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("confirm mfa: %w", err)
+	}
+
+	return &api.MfaRecoveryCodes{RecoveryCodes: codes}, nil
+}
+
+// Reset implements MFAService
+func (s *mfaServiceImpl) Reset(ctx context.Context, params api.ResetUserMfaParams) (api.ResetUserMfaRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	userID, err := uuid.Parse(params.UserId)
+	if err != nil {
+		return &api.ResetUserMfaNotFound{}, nil
+	}
+	if err := s.db.WithContext(ctx).Select("id").Where("id = ?", userID).First(&models.User{}).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.ResetUserMfaNotFound{}, nil
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, model := range []interface{}{&models.MFAChallenge{}, &models.MFARecoveryCode{}, &models.UserMFA{}} {
+			if err := tx.Where("user_id = ?", userID).Delete(model).Error; err != nil {
+				return fmt.Errorf("reset mfa: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ResetUserMfaNoContent{}, nil
+}
+
+// mfaEnabled reports whether the user has a confirmed TOTP enrolment
+func mfaEnabled(db *gorm.DB, userID uuid.UUID) (bool, error) {
+	var count int64
+	if err := db.Model(&models.UserMFA{}).
+		Where("user_id = ? AND confirmed_at IS NOT NULL", userID).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("check mfa: %w", err)
+	}
+	return count > 0, nil
+}
+
+// createMFAChallenge opens the second login step for the user
+func createMFAChallenge(db *gorm.DB, userID uuid.UUID) (*api.MfaChallenge, error) {
+	token, err := generateSecret(32)
+	if err != nil {
+		return nil, err
+	}
+	challenge := &models.MFAChallenge{
+		UserID:    userID,
+		TokenHash: hashSecret(token),
+		ExpiresAt: time.Now().Add(mfaChallengeTTL),
+	}
+	if err := db.Create(challenge).Error; err != nil {
+		return nil, fmt.Errorf("create mfa challenge: %w", err)
+	}
+	return &api.MfaChallenge{MfaToken: token, ExpiresAt: challenge.ExpiresAt}, nil
+}
+
+// checkMFACode accepts either a TOTP code newer than the last one used or an
+// unused recovery code, consuming it. It reports false for anything else.
+func checkMFACode(tx *gorm.DB, userID uuid.UUID, code, recoveryCode string) (bool, error) {
+	if code != "" {
+		var enrollment models.UserMFA
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND confirmed_at IS NOT NULL", userID).
+			First(&enrollment).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return false, nil
+			}
+			return false, fmt.Errorf("get mfa: %w", err)
+		}
+		step, ok := verifyTOTP(enrollment.Secret, code, time.Now())
+		if !ok || step <= enrollment.LastUsedStep {
+			return false, nil
+		}
+		if err := tx.Model(&enrollment).Update("last_used_step", step).Error; err != nil {
+			return false, fmt.Errorf("record mfa step: %w", err)
+		}
+		return true, nil
+	}
+
+	if recoveryCode != "" {
+		result := tx.Model(&models.MFARecoveryCode{}).
+			Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, hashSecret(normalizeRecoveryCode(recoveryCode))).
+			Update("used_at", time.Now())
+		if result.Error != nil {
+			return false, fmt.Errorf("use recovery code: %w", result.Error)
+		}
+		return result.RowsAffected == 1, nil
+	}
+
+	return false, nil
+}
+
+// replaceRecoveryCodes discards the user's recovery codes and issues a new set
+func replaceRecoveryCodes(tx *gorm.DB, userID uuid.UUID) ([]string, error) {
+	if err := tx.Where("user_id = ?", userID).Delete(&models.MFARecoveryCode{}).Error; err != nil {
+		return nil, fmt.Errorf("delete recovery codes: %w", err)
+	}
+
+	codes := make([]string, recoveryCodeCount)
+	rows := make([]models.MFARecoveryCode, recoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, fmt.Errorf("generate recovery code: %w", err)
+		}
+		raw := strings.ToLower(base32.StdEncoding.EncodeToString(b))
+		codes[i] = raw[:4] + "-" + raw[4:]
+		rows[i] = models.MFARecoveryCode{UserID: userID, CodeHash: hashSecret(raw)}
+	}
+	if err := tx.Create(&rows).Error; err != nil {
+		return nil, fmt.Errorf("create recovery codes: %w", err)
+	}
+	return codes, nil
+}
+
+// normalizeRecoveryCode strips the formatting users may type with a recovery code
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
@@ -7,12 +7,28 @@ import (
 
 // AutoMigrate runs database migrations for all models
 func AutoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(
+	if err := db.AutoMigrate(
 		&models.User{},
+		&models.Session{},
+		&models.RefreshToken{},
+		&models.Invitation{},
+		&models.PasswordReset{},
+		&models.PasswordHistory{},
+		&models.LoginThrottle{},
+		&models.UserMFA{},
+		&models.MFARecoveryCode{},
+		&models.MFAChallenge{},
+		&models.SSOState{},
+		&models.APIKey{},
+		&models.ImpersonationEvent{},
+		&models.LoginEvent{},
 		&models.Task{},
 		&models.App{},
 		&models.ChatUser{},
 		&models.ChatConversation{},
 		&models.ChatMessage{},
-	)
+	); err != nil {
+		return err
+	}
+	return createUserSearchIndex(db)
 }
@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/google/uuid"
 	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
 )
 
@@ -18,6 +20,12 @@ type OgenHandler struct {
 	appService       AppService
 	chatService      ChatService
 	dashboardService DashboardService
+	sessionService   SessionService
+	inviteService    InvitationService
+	passwordService  PasswordService
+	mfaService       MFAService
+	apiKeyService    APIKeyService
+	loginHistory     LoginHistoryService
 }
 
 // OgenHandlerBuilder builds an OgenHandler with optional services
@@ -28,6 +36,12 @@ type OgenHandlerBuilder struct {
 	appService       AppService
 	chatService      ChatService
 	dashboardService DashboardService
+	sessionService   SessionService
+	inviteService    InvitationService
+	passwordService  PasswordService
+	mfaService       MFAService
+	apiKeyService    APIKeyService
+	loginHistory     LoginHistoryService
 }
 
 // NewOgenHandler creates a new OgenHandler builder
@@ -71,6 +85,42 @@ func (b *OgenHandlerBuilder) WithDashboardService(svc DashboardService) *OgenHan
 	return b
 }
 
+// WithSessionService adds session service
+func (b *OgenHandlerBuilder) WithSessionService(svc SessionService) *OgenHandlerBuilder {
+	b.sessionService = svc
+	return b
+}
+
+// WithInvitationService adds invitation service
+func (b *OgenHandlerBuilder) WithInvitationService(svc InvitationService) *OgenHandlerBuilder {
+	b.inviteService = svc
+	return b
+}
+
+// WithPasswordService adds password service
+func (b *OgenHandlerBuilder) WithPasswordService(svc PasswordService) *OgenHandlerBuilder {
+	b.passwordService = svc
+	return b
+}
+
+// WithMFAService adds MFA service
+func (b *OgenHandlerBuilder) WithMFAService(svc MFAService) *OgenHandlerBuilder {
+	b.mfaService = svc
+	return b
+}
+
+// WithAPIKeyService adds API key service
+func (b *OgenHandlerBuilder) WithAPIKeyService(svc APIKeyService) *OgenHandlerBuilder {
+	b.apiKeyService = svc
+	return b
+}
+
+// WithLoginHistoryService adds login history service
+func (b *OgenHandlerBuilder) WithLoginHistoryService(svc LoginHistoryService) *OgenHandlerBuilder {
+	b.loginHistory = svc
+	return b
+}
+
 // Build creates the OgenHandler instance
 func (b *OgenHandlerBuilder) Build() *OgenHandler {
 	return &OgenHandler{
@@ -80,11 +130,47 @@ func (b *OgenHandlerBuilder) Build() *OgenHandler {
 		appService:       b.appService,
 		chatService:      b.chatService,
 		dashboardService: b.dashboardService,
+		sessionService:   b.sessionService,
+		inviteService:    b.inviteService,
+		passwordService:  b.passwordService,
+		mfaService:       b.mfaService,
+		apiKeyService:    b.apiKeyService,
+		loginHistory:     b.loginHistory,
 	}
 }
 
-// Ensure OgenHandler implements api.Handler
-var _ api.Handler = (*OgenHandler)(nil)
+// Ensure OgenHandler implements api.Handler and api.SecurityHandler
+var (
+	_ api.Handler         = (*OgenHandler)(nil)
+	_ api.SecurityHandler = (*OgenHandler)(nil)
+)
+
+// ============================================================================
+// Security - validate bearer tokens via AuthService
+// ============================================================================
+
+// HandleBearerAuth implements api.SecurityHandler
+// It validates the access token, checks the principal's role against the
+// permission matrix and places the authenticated Principal on the context
+func (h *OgenHandler) HandleBearerAuth(ctx context.Context, operationName api.OperationName, t api.BearerAuth) (context.Context, error) {
+	if h.authService == nil {
+		return nil, ErrMissingRequired
+	}
+	principal, err := h.authService.Authenticate(ctx, t.Token)
+	if err != nil {
+		return nil, err
+	}
+	if !IsOperationAllowed(operationName, principal.Role) {
+		return nil, fmt.Errorf("%s as %s: %w", operationName, principal.Role, ErrForbidden)
+	}
+	if principal.APIKeyID != uuid.Nil && !IsOperationInScope(operationName, principal.Scopes) {
+		return nil, fmt.Errorf("%s with api key %s: %w", operationName, principal.APIKeyID, ErrForbidden)
+	}
+	if principal.ImpersonatorID != uuid.Nil && !IsOperationAllowedWhileImpersonating(operationName) {
+		return nil, fmt.Errorf("%s while impersonated by %s: %w", operationName, principal.ImpersonatorID, ErrForbidden)
+	}
+	return ContextWithPrincipal(ctx, principal), nil
+}
 
 // ============================================================================
 // Auth Operations - delegate to AuthService
@@ -98,6 +184,14 @@ func (h *OgenHandler) Login(ctx context.Context, req *api.LoginRequest) (api.Log
 	return h.authService.Login(ctx, req)
 }
 
+// RefreshToken implements api.Handler
+func (h *OgenHandler) RefreshToken(ctx context.Context, req *api.RefreshTokenRequest) (api.RefreshTokenRes, error) {
+	if h.authService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.authService.Refresh(ctx, req)
+}
+
 // Logout implements api.Handler
 func (h *OgenHandler) Logout(ctx context.Context) error {
 	if h.authService == nil {
@@ -114,6 +208,130 @@ func (h *OgenHandler) GetCurrentUser(ctx context.Context) (api.GetCurrentUserRes
 	return h.authService.GetCurrentUser(ctx)
 }
 
+// VerifyMfa implements api.Handler
+func (h *OgenHandler) VerifyMfa(ctx context.Context, req *api.VerifyMfaRequest) (api.VerifyMfaRes, error) {
+	if h.authService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.authService.VerifyMFA(ctx, req)
+}
+
+// StartSso implements api.Handler
+func (h *OgenHandler) StartSso(ctx context.Context) (api.StartSsoRes, error) {
+	if h.authService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.authService.StartSSO(ctx)
+}
+
+// CompleteSso implements api.Handler
+func (h *OgenHandler) CompleteSso(ctx context.Context, req *api.SsoCallbackRequest) (api.CompleteSsoRes, error) {
+	if h.authService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.authService.CompleteSSO(ctx, req)
+}
+
+// StopImpersonation implements api.Handler
+func (h *OgenHandler) StopImpersonation(ctx context.Context) (api.StopImpersonationRes, error) {
+	if h.authService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.authService.StopImpersonation(ctx)
+}
+
+// ImpersonateUser implements api.Handler
+func (h *OgenHandler) ImpersonateUser(ctx context.Context, req *api.ImpersonateRequest, params api.ImpersonateUserParams) (api.ImpersonateUserRes, error) {
+	if h.authService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.authService.Impersonate(ctx, req, params)
+}
+
+// ============================================================================
+// MFA Operations - delegate to MFAService
+// ============================================================================
+
+// EnrollMfa implements api.Handler
+func (h *OgenHandler) EnrollMfa(ctx context.Context) (api.EnrollMfaRes, error) {
+	if h.mfaService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.mfaService.Enroll(ctx)
+}
+
+// ConfirmMfa implements api.Handler
+func (h *OgenHandler) ConfirmMfa(ctx context.Context, req *api.ConfirmMfaRequest) (api.ConfirmMfaRes, error) {
+	if h.mfaService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.mfaService.Confirm(ctx, req)
+}
+
+// ResetUserMfa implements api.Handler
+func (h *OgenHandler) ResetUserMfa(ctx context.Context, params api.ResetUserMfaParams) (api.ResetUserMfaRes, error) {
+	if h.mfaService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.mfaService.Reset(ctx, params)
+}
+
+// ============================================================================
+// API Key Operations - delegate to APIKeyService
+// ============================================================================
+
+// ListApiKeys implements api.Handler
+func (h *OgenHandler) ListApiKeys(ctx context.Context) (*api.ApiKeyListResponse, error) {
+	if h.apiKeyService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.apiKeyService.List(ctx)
+}
+
+// CreateApiKey implements api.Handler
+func (h *OgenHandler) CreateApiKey(ctx context.Context, req *api.CreateApiKeyRequest) (api.CreateApiKeyRes, error) {
+	if h.apiKeyService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.apiKeyService.Create(ctx, req)
+}
+
+// RevokeApiKey implements api.Handler
+func (h *OgenHandler) RevokeApiKey(ctx context.Context, params api.RevokeApiKeyParams) (api.RevokeApiKeyRes, error) {
+	if h.apiKeyService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.apiKeyService.Revoke(ctx, params)
+}
+
+// ============================================================================
+// Password Operations - delegate to PasswordService
+// ============================================================================
+
+// ForgotPassword implements api.Handler
+func (h *OgenHandler) ForgotPassword(ctx context.Context, req *api.ForgotPasswordRequest) error {
+	if h.passwordService == nil {
+		return ErrMissingRequired
+	}
+	return h.passwordService.Forgot(ctx, req)
+}
+
+// ResetPassword implements api.Handler
+func (h *OgenHandler) ResetPassword(ctx context.Context, req *api.ResetPasswordRequest) (api.ResetPasswordRes, error) {
+	if h.passwordService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.passwordService.Reset(ctx, req)
+}
+
+// ChangePassword implements api.Handler
+func (h *OgenHandler) ChangePassword(ctx context.Context, req *api.ChangePasswordRequest) (api.ChangePasswordRes, error) {
+	if h.passwordService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.passwordService.Change(ctx, req)
+}
+
 // ============================================================================
 // User Operations - delegate to UserService
 // ============================================================================
@@ -166,6 +384,126 @@ func (h *OgenHandler) InviteUser(ctx context.Context, req *api.InviteUserRequest
 	return h.userService.Invite(ctx, req)
 }
 
+// UnlockUser implements api.Handler
+func (h *OgenHandler) UnlockUser(ctx context.Context, params api.UnlockUserParams) (api.UnlockUserRes, error) {
+	if h.userService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.userService.Unlock(ctx, params)
+}
+
+// ImportUsers implements api.Handler
+func (h *OgenHandler) ImportUsers(ctx context.Context, req *api.ImportUsersReq) (api.ImportUsersRes, error) {
+	if h.userService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.userService.Import(ctx, req)
+}
+
+// ExportUsers implements api.Handler
+func (h *OgenHandler) ExportUsers(ctx context.Context, params api.ExportUsersParams) (api.ExportUsersRes, error) {
+	if h.userService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.userService.Export(ctx, params)
+}
+
+// RestoreUser implements api.Handler
+func (h *OgenHandler) RestoreUser(ctx context.Context, params api.RestoreUserParams) (api.RestoreUserRes, error) {
+	if h.userService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.userService.Restore(ctx, params)
+}
+
+// PurgeUser implements api.Handler
+func (h *OgenHandler) PurgeUser(ctx context.Context, params api.PurgeUserParams) (api.PurgeUserRes, error) {
+	if h.userService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.userService.Purge(ctx, params)
+}
+
+// BulkUserAction implements api.Handler
+func (h *OgenHandler) BulkUserAction(ctx context.Context, req *api.BulkUserActionRequest) (api.BulkUserActionRes, error) {
+	if h.userService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.userService.BulkAction(ctx, req)
+}
+
+// ============================================================================
+// Session Operations - delegate to SessionService
+// ============================================================================
+
+// ListUserSessions implements api.Handler
+func (h *OgenHandler) ListUserSessions(ctx context.Context, params api.ListUserSessionsParams) (api.ListUserSessionsRes, error) {
+	if h.sessionService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.sessionService.List(ctx, params)
+}
+
+// RevokeUserSession implements api.Handler
+func (h *OgenHandler) RevokeUserSession(ctx context.Context, params api.RevokeUserSessionParams) (api.RevokeUserSessionRes, error) {
+	if h.sessionService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.sessionService.Revoke(ctx, params)
+}
+
+// RevokeUserSessions implements api.Handler
+func (h *OgenHandler) RevokeUserSessions(ctx context.Context, params api.RevokeUserSessionsParams) (api.RevokeUserSessionsRes, error) {
+	if h.sessionService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.sessionService.RevokeAll(ctx, params)
+}
+
+// ListLoginHistory implements api.Handler
+func (h *OgenHandler) ListLoginHistory(ctx context.Context, params api.ListLoginHistoryParams) (api.ListLoginHistoryRes, error) {
+	if h.loginHistory == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.loginHistory.List(ctx, params)
+}
+
+// ============================================================================
+// Invitation Operations - delegate to InvitationService
+// ============================================================================
+
+// GetInvitation implements api.Handler
+func (h *OgenHandler) GetInvitation(ctx context.Context, params api.GetInvitationParams) (api.GetInvitationRes, error) {
+	if h.inviteService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.inviteService.Get(ctx, params)
+}
+
+// AcceptInvitation implements api.Handler
+func (h *OgenHandler) AcceptInvitation(ctx context.Context, req *api.AcceptInvitationRequest, params api.AcceptInvitationParams) (api.AcceptInvitationRes, error) {
+	if h.inviteService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.inviteService.Accept(ctx, req, params)
+}
+
+// ResendInvitation implements api.Handler
+func (h *OgenHandler) ResendInvitation(ctx context.Context, params api.ResendInvitationParams) (api.ResendInvitationRes, error) {
+	if h.inviteService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.inviteService.Resend(ctx, params)
+}
+
+// RevokeInvitation implements api.Handler
+func (h *OgenHandler) RevokeInvitation(ctx context.Context, params api.RevokeInvitationParams) (api.RevokeInvitationRes, error) {
+	if h.inviteService == nil {
+		return nil, ErrMissingRequired
+	}
+	return h.inviteService.Revoke(ctx, params)
+}
+
 // ============================================================================
 // Task Operations - delegate to TaskService
 // ============================================================================
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"gorm.io/gorm"
+)
+
+// cursor is the decoded form of the opaque cursors handed out in list responses
+type cursor struct {
+	// Sort is the ordering the cursor was issued for
+	Sort string `json:"s"`
+	// Values is the sort key of the row the next page continues from
+	Values []any `json:"v"`
+	// Backward selects the rows before the key instead of after it
+	Backward bool `json:"b,omitempty"`
+	// Page is the number of the page the cursor leads to
+	Page int `json:"p"`
+}
+
+// encodeCursor returns the cursor continuing from a row with the given sort key
+// to the given page
+func encodeCursor(fields []sortField, values []any, backward bool, page int) (string, error) {
+	data, err := json.Marshal(cursor{Sort: sortSpec(fields), Values: values, Backward: backward, Page: page})
+	if err != nil {
+		return "", fmt.Errorf("encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor parses a cursor issued for the same ordering
+func decodeCursor(token string, fields []sortField) (*cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("decode cursor: %w", ErrInvalidCursor)
+	}
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("decode cursor: %w", ErrInvalidCursor)
+	}
+	// A cursor only has meaning in the ordering it was taken from
+	if c.Sort != sortSpec(fields) || len(c.Values) != len(fields) || c.Page < 1 {
+		return nil, fmt.Errorf("cursor for sort %q used with %q: %w", c.Sort, sortSpec(fields), ErrInvalidCursor)
+	}
+	for i, f := range fields {
+		switch v := c.Values[i].(type) {
+		case string:
+			if f.Time {
+				t, err := time.Parse(time.RFC3339Nano, v)
+				if err != nil {
+					return nil, fmt.Errorf("cursor value for %s: %w", f.Name, ErrInvalidCursor)
+				}
+				c.Values[i] = t
+			}
+		case float64:
+			// Numeric sort keys are integers such as search ranks
+			if f.Time || v != math.Trunc(v) {
+				return nil, fmt.Errorf("cursor value for %s: %w", f.Name, ErrInvalidCursor)
+			}
+			c.Values[i] = int64(v)
+		default:
+			return nil, fmt.Errorf("cursor value for %s: %w", f.Name, ErrInvalidCursor)
+		}
+	}
+	return &c, nil
+}
+
+// keysetCondition selects the rows that come after values in the ordering of
+// fields, or before them when backward
+func keysetCondition(fields []sortField, values []any, backward bool) (string, []any) {
+	var (
+		ors  []string
+		args []any
+	)
+	for i, f := range fields {
+		var ands []string
+		for j := 0; j < i; j++ {
+			ands = append(ands, fields[j].Name+" = ?")
+			args = append(args, values[j])
+		}
+		op := " > ?"
+		if f.Desc != backward {
+			op = " < ?"
+		}
+		ands = append(ands, f.Name+op)
+		args = append(args, values[i])
+		ors = append(ors, "("+strings.Join(ands, " AND ")+")")
+	}
+	return "(" + strings.Join(ors, " OR ") + ")", args
+}
+
+// listPage loads one page of a sorted list. Page-numbered requests use OFFSET;
+// cursor requests seek from the cursor's sort key, so rows inserted before the
+// cursor do not shift the page. Both report totals and return cursors for the
+// neighbouring pages. sortValue returns a row's value for a sort column.
+func listPage[T any](query *gorm.DB, fields []sortField, page, pageSize int, token string, sortValue func(T, string) any) ([]T, api.PaginationMeta, api.PageCursors, error) {
+	var cursors api.PageCursors
+	rowKey := func(row T) []any {
+		values := make([]any, len(fields))
+		for i, f := range fields {
+			values[i] = sortValue(row, f.Name)
+		}
+		return values
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, api.PaginationMeta{}, cursors, fmt.Errorf("count rows: %w", err)
+	}
+	totalPages := int(total) / pageSize
+	if int(total)%pageSize > 0 {
+		totalPages++
+	}
+
+	var (
+		rows             []T
+		hasPrev, hasNext bool
+	)
+	if token == "" {
+		if err := applySort(query, fields).Offset((page - 1) * pageSize).Limit(pageSize).Find(&rows).Error; err != nil {
+			return nil, api.PaginationMeta{}, cursors, fmt.Errorf("query rows: %w", err)
+		}
+		hasPrev, hasNext = page > 1, page < totalPages
+	} else {
+		c, err := decodeCursor(token, fields)
+		if err != nil {
+			return nil, api.PaginationMeta{}, cursors, err
+		}
+		order := fields
+		if c.Backward {
+			order = reverseSort(fields)
+		}
+		condition, args := keysetCondition(fields, c.Values, c.Backward)
+
+		// One extra row tells whether another page follows in this direction
+		if err := applySort(query.Where(condition, args...), order).Limit(pageSize + 1).Find(&rows).Error; err != nil {
+			return nil, api.PaginationMeta{}, cursors, fmt.Errorf("query rows: %w", err)
+		}
+		more := len(rows) > pageSize
+		if more {
+			rows = rows[:pageSize]
+		}
+		page = c.Page
+		if c.Backward {
+			slices.Reverse(rows)
+			hasPrev, hasNext = more, true
+			// Paging back can reach the start early when rows were deleted
+			if !more {
+				page = 1
+			}
+		} else {
+			hasPrev, hasNext = true, more
+		}
+	}
+
+	if len(rows) > 0 && hasPrev {
+		// Inserts ahead of the cursor can leave rows before page 1
+		prev, err := encodeCursor(fields, rowKey(rows[0]), true, max(page-1, 1))
+		if err != nil {
+			return nil, api.PaginationMeta{}, cursors, err
+		}
+		cursors.Prev = api.NewOptString(prev)
+	}
+	if len(rows) > 0 && hasNext {
+		next, err := encodeCursor(fields, rowKey(rows[len(rows)-1]), false, page+1)
+		if err != nil {
+			return nil, api.PaginationMeta{}, cursors, err
+		}
+		cursors.Next = api.NewOptString(next)
+	}
+	meta := api.PaginationMeta{Page: page, PageSize: pageSize, Total: int(total), TotalPages: totalPages}
+	return rows, meta, cursors, nil
+}
@@ -0,0 +1,260 @@
+package services
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// maxPasswordBytes is the longest password bcrypt can hash
+const maxPasswordBytes = 72
+
+// currentBcryptPrefix is the bcrypt version written by golang.org/x/crypto
+const currentBcryptPrefix = "$2a$"
+
+// Password policy violation codes
+const (
+	PasswordTooShort         = "PASSWORD_TOO_SHORT"
+	PasswordTooLong          = "PASSWORD_TOO_LONG"
+	PasswordMissingUppercase = "PASSWORD_MISSING_UPPERCASE"
+	PasswordMissingLowercase = "PASSWORD_MISSING_LOWERCASE"
+	PasswordMissingDigit     = "PASSWORD_MISSING_DIGIT"
+	PasswordMissingSymbol    = "PASSWORD_MISSING_SYMBOL"
+	PasswordTooCommon        = "PASSWORD_TOO_COMMON"
+	PasswordReused           = "PASSWORD_REUSED"
+)
+
+// PasswordPolicy describes the rules new passwords must satisfy and how they are hashed
+type PasswordPolicy struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// HistorySize is how many of the user's most recent passwords, including
+	// the current one, may not be chosen again. Zero allows reuse.
+	HistorySize int
+	// HashCost is the bcrypt cost for new hashes. Stored hashes with a lower
+	// cost are upgraded on the next successful login.
+	HashCost int
+	// Common is the set of lower-cased passwords rejected as too common
+	Common map[string]struct{}
+}
+
+// DefaultPasswordPolicy returns the policy used when none is configured
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:    8,
+		RequireLower: true,
+		RequireDigit: true,
+		HistorySize:  5,
+		HashCost:     bcrypt.DefaultCost,
+	}
+}
+
+// LoadCommonPasswords reads a newline-separated password list, such as a
+// breached-password dump, for PasswordPolicy.Common. Blank lines and lines
+// starting with # are skipped.
+func LoadCommonPasswords(path string) (map[string]struct{}, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open common passwords: %w", err)
+	}
+	defer f.Close()
+
+	common := make(map[string]struct{})
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		common[strings.ToLower(line)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read common passwords: %w", err)
+	}
+	return common, nil
+}
+
+// PasswordPolicyError lists every rule a password broke
+type PasswordPolicyError struct {
+	Violations []FieldViolation
+}
+
+// Error implements error
+func (e *PasswordPolicyError) Error() string {
+	codes := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		codes[i] = v.Field + ": " + v.Code
+	}
+	return ErrPasswordPolicy.Error() + " (" + strings.Join(codes, ", ") + ")"
+}
+
+// Unwrap lets errors.Is match ErrPasswordPolicy
+func (e *PasswordPolicyError) Unwrap() error {
+	return ErrPasswordPolicy
+}
+
+// FieldViolations returns the violations for the error response
+func (e *PasswordPolicyError) FieldViolations() []FieldViolation {
+	return e.Violations
+}
+
+// Validate checks the password against the policy rules that need no stored
+// state. field names the request field reported in violations.
+func (p PasswordPolicy) Validate(field, password string) error {
+	var violations []FieldViolation
+	add := func(code, message string) {
+		violations = append(violations, FieldViolation{Field: field, Code: code, Message: message})
+	}
+
+	if n := len([]rune(password)); n < p.MinLength {
+		add(PasswordTooShort, fmt.Sprintf("Password must be at least %d characters", p.MinLength))
+	}
+	if len(password) > maxPasswordBytes {
+		add(PasswordTooLong, fmt.Sprintf("Password must be at most %d bytes", maxPasswordBytes))
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+	if p.RequireUpper && !hasUpper {
+		add(PasswordMissingUppercase, "Password must contain an uppercase letter")
+	}
+	if p.RequireLower && !hasLower {
+		add(PasswordMissingLowercase, "Password must contain a lowercase letter")
+	}
+	if p.RequireDigit && !hasDigit {
+		add(PasswordMissingDigit, "Password must contain a digit")
+	}
+	if p.RequireSymbol && !hasSymbol {
+		add(PasswordMissingSymbol, "Password must contain a symbol")
+	}
+
+	if _, ok := p.Common[strings.ToLower(password)]; ok {
+		add(PasswordTooCommon, "Password is too common")
+	}
+
+	if len(violations) > 0 {
+		return &PasswordPolicyError{Violations: violations}
+	}
+	return nil
+}
+
+// hash hashes a plaintext password for storage
+func (p PasswordPolicy) hash(password string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), p.HashCost)
+	if err != nil {
+		return "", fmt.Errorf("hash password: %w", err)
+	}
+	return string(hashed), nil
+}
+
+// needsRehash reports whether a stored hash predates the policy's bcrypt
+// version or cost
+func (p PasswordPolicy) needsRehash(hash string) bool {
+	if !strings.HasPrefix(hash, currentBcryptPrefix) {
+		return true
+	}
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost < p.HashCost
+}
+
+// setPassword validates the password against the policy and the user's
+// password history, then stores it and records the replaced hash. It runs
+// inside the caller's transaction.
+func (p PasswordPolicy) setPassword(tx *gorm.DB, user models.User, field, password string) error {
+	if err := p.Validate(field, password); err != nil {
+		return err
+	}
+
+	reused, err := p.isReused(tx, user, password)
+	if err != nil {
+		return err
+	}
+	if reused {
+		return &PasswordPolicyError{Violations: []FieldViolation{{
+			Field:   field,
+			Code:    PasswordReused,
+			Message: fmt.Sprintf("Password must differ from your last %d passwords", p.HistorySize),
+		}}}
+	}
+
+	hashed, err := p.hash(password)
+	if err != nil {
+		return err
+	}
+	if err := tx.Model(&models.User{}).Where("id = ?", user.ID).Update("password", hashed).Error; err != nil {
+		return fmt.Errorf("update password: %w", err)
+	}
+
+	// Invited and link-onboarded users have no previous password to remember
+	if user.Password == "" || p.HistorySize <= 1 {
+		return nil
+	}
+	if err := tx.Create(&models.PasswordHistory{UserID: user.ID, PasswordHash: user.Password}).Error; err != nil {
+		return fmt.Errorf("record password history: %w", err)
+	}
+	return prunePasswordHistory(tx, user.ID, p.HistorySize-1)
+}
+
+// isReused reports whether the password matches the user's current password
+// or one of the previous ones covered by HistorySize
+func (p PasswordPolicy) isReused(tx *gorm.DB, user models.User, password string) (bool, error) {
+	if p.HistorySize <= 0 {
+		return false, nil
+	}
+
+	hashes := []string{user.Password}
+	if p.HistorySize > 1 {
+		var previous []models.PasswordHistory
+		if err := tx.Where("user_id = ?", user.ID).
+			Order("created_at DESC").
+			Limit(p.HistorySize - 1).
+			Find(&previous).Error; err != nil {
+			return false, fmt.Errorf("list password history: %w", err)
+		}
+		for _, h := range previous {
+			hashes = append(hashes, h.PasswordHash)
+		}
+	}
+
+	for _, h := range hashes {
+		if h != "" && bcrypt.CompareHashAndPassword([]byte(h), []byte(password)) == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// prunePasswordHistory keeps only the user's keep most recent previous passwords
+func prunePasswordHistory(tx *gorm.DB, userID uuid.UUID, keep int) error {
+	recent := tx.Model(&models.PasswordHistory{}).
+		Select("id").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Limit(keep)
+	if err := tx.Where("user_id = ? AND id NOT IN (?)", userID, recent).
+		Delete(&models.PasswordHistory{}).Error; err != nil {
+		return fmt.Errorf("prune password history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,260 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// defaultPasswordResetTTL is how long a forgot-password link stays valid
+const defaultPasswordResetTTL = time.Hour
+
+// PasswordService interface for password reset and change operations
+type PasswordService interface {
+	Forgot(ctx context.Context, req *api.ForgotPasswordRequest) error
+	Reset(ctx context.Context, req *api.ResetPasswordRequest) (api.ResetPasswordRes, error)
+	Change(ctx context.Context, req *api.ChangePasswordRequest) (api.ChangePasswordRes, error)
+}
+
+// passwordServiceImpl implements PasswordService
+type passwordServiceImpl struct {
+	db     *gorm.DB
+	policy PasswordPolicy
+	resets passwordResetSender
+}
+
+// passwordServiceBuilder is the builder for PasswordService
+type passwordServiceBuilder struct {
+	db     *gorm.DB
+	policy PasswordPolicy
+	mailer Mailer
+	appURL string
+}
+
+// NewPasswordService creates a new PasswordService builder
+func NewPasswordService(db *gorm.DB) *passwordServiceBuilder {
+	return &passwordServiceBuilder{db: db, policy: DefaultPasswordPolicy(), mailer: unconfiguredMailer{}, appURL: defaultAppURL}
+}
+
+// WithPasswordPolicy sets the rules new passwords must satisfy
+func (b *passwordServiceBuilder) WithPasswordPolicy(policy PasswordPolicy) *passwordServiceBuilder {
+	b.policy = policy
+	return b
+}
+
+// WithMailer sets the mailer used to deliver reset links. Without
+// one, sending fails.
+func (b *passwordServiceBuilder) WithMailer(mailer Mailer) *passwordServiceBuilder {
+	b.mailer = mailer
+	return b
+}
+
+// WithAppURL sets the frontend base URL used in reset links
+func (b *passwordServiceBuilder) WithAppURL(appURL string) *passwordServiceBuilder {
+	b.appURL = appURL
+	return b
+}
+
+// Build creates the PasswordService
+func (b *passwordServiceBuilder) Build() PasswordService {
+	return &passwordServiceImpl{
+		db:     b.db,
+		policy: b.policy,
+		resets: passwordResetSender{mailer: b.mailer, appURL: b.appURL},
+	}
+}
+
+// Forgot implements PasswordService
+func (s *passwordServiceImpl) Forgot(ctx context.Context, req *api.ForgotPasswordRequest) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	var user models.User
+	if err := s.db.WithContext(ctx).Where("email = ?", req.Email).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Same response as for known emails so accounts cannot be enumerated
+			return nil
+		}
+		return fmt.Errorf("get user: %w", err)
+	}
+
+	// Invited users onboard through their invitation; blocked users stay blocked
+	if user.Status != string(api.UserStatusActive) {
+		return nil
+	}
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return s.resets.send(ctx, tx, user, defaultPasswordResetTTL,
+			"Reset your Shadcn Admin password",
+			"Someone asked to reset the password for your Shadcn Admin account.\n\n"+
+				"Choose a new password here:\n%s\n\n"+
+				"This link expires on %s. If you did not ask for this, ignore this email.")
+	})
+}
+
+// Reset implements PasswordService
+func (s *passwordServiceImpl) Reset(ctx context.Context, req *api.ResetPasswordRequest) (api.ResetPasswordRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var reset models.PasswordReset
+		if err := tx.Where("token_hash = ? AND used_at IS NULL AND expires_at > ?",
+			hashSecret(req.Token), time.Now()).First(&reset).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidResetToken
+			}
+			return fmt.Errorf("get password reset: %w", err)
+		}
+
+		// Conditional update keeps the token single-use under concurrent resets
+		result := tx.Model(&models.PasswordReset{}).
+			Where("id = ? AND used_at IS NULL", reset.ID).
+			Update("used_at", time.Now())
+		if result.Error != nil {
+			return fmt.Errorf("use password reset: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrInvalidResetToken
+		}
+
+		var user models.User
+		if err := tx.Where("id = ?", reset.UserID).First(&user).Error; err != nil {
+			return fmt.Errorf("get user: %w", err)
+		}
+		// A rejected password rolls back, leaving the token usable for another try
+		if err := s.policy.setPassword(tx, user, "password", req.Password); err != nil {
+			return err
+		}
+
+		// Whoever may have known the old password loses their sessions
+		return revokeUserSessions(ctx, tx, reset.UserID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reset password: %w", err)
+	}
+
+	return &api.ResetPasswordNoContent{}, nil
+}
+
+// Change implements PasswordService
+func (s *passwordServiceImpl) Change(ctx context.Context, req *api.ChangePasswordRequest) (api.ChangePasswordRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+
+	var user models.User
+	if err := s.db.WithContext(ctx).Where("id = ?", principal.UserID).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUnauthorized
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.CurrentPassword)); err != nil {
+		return nil, fmt.Errorf("change password: %w", ErrIncorrectPassword)
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := s.policy.setPassword(tx, user, "newPassword", req.NewPassword); err != nil {
+			return err
+		}
+		// Sign out every other session; the caller stays logged in
+		var others []models.Session
+		if err := tx.Where("user_id = ? AND id <> ?", user.ID, principal.TokenID).Find(&others).Error; err != nil {
+			return fmt.Errorf("list sessions: %w", err)
+		}
+		for _, session := range others {
+			if err := revokeSession(ctx, tx, session.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ChangePasswordNoContent{}, nil
+}
+
+// passwordResetSender issues password reset tokens and mails the reset link
+type passwordResetSender struct {
+	mailer Mailer
+	appURL string
+}
+
+// send invalidates the user's open reset tokens, stores a new one valid for
+// ttl and mails its link. body is a format string receiving the link and the
+// expiry time. It runs inside the caller's transaction so a failed delivery
+// rolls back.
+func (s passwordResetSender) send(ctx context.Context, tx *gorm.DB, user models.User, ttl time.Duration, subject, body string) error {
+	msg, err := s.prepare(tx, user, ttl, subject, body)
+	if err != nil {
+		return err
+	}
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		return fmt.Errorf("send password reset: %w", err)
+	}
+	return nil
+}
+
+// prepare is send without the delivery: it stores the token and returns the
+// message carrying its link, for callers that send it themselves
+func (s passwordResetSender) prepare(tx *gorm.DB, user models.User, ttl time.Duration, subject, body string) (MailMessage, error) {
+	if err := expirePasswordResets(tx, user.ID); err != nil {
+		return MailMessage{}, err
+	}
+
+	token, err := generateSecret(32)
+	if err != nil {
+		return MailMessage{}, err
+	}
+	reset := &models.PasswordReset{
+		UserID:    user.ID,
+		TokenHash: hashSecret(token),
+		ExpiresAt: time.Now().Add(ttl),
+	}
+	if err := tx.Create(reset).Error; err != nil {
+		return MailMessage{}, fmt.Errorf("create password reset: %w", err)
+	}
+
+	link := strings.TrimRight(s.appURL, "/") + "/reset-password?token=" + url.QueryEscape(token)
+	return MailMessage{
+		To:      user.Email,
+		Subject: subject,
+		Body:    fmt.Sprintf(body, link, reset.ExpiresAt.UTC().Format(time.RFC1123)),
+	}, nil
+}
+
+// expirePasswordResets marks the user's unused reset tokens as used
+func expirePasswordResets(tx *gorm.DB, userID uuid.UUID) error {
+	if err := tx.Model(&models.PasswordReset{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Update("used_at", time.Now()).Error; err != nil {
+		return fmt.Errorf("expire password resets: %w", err)
+	}
+	return nil
+}
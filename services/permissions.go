@@ -0,0 +1,171 @@
+package services
+
+import (
+	"slices"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+)
+
+// User roles as defined by the UserRole schema
+const (
+	RoleSuperadmin = string(api.UserRoleSuperadmin)
+	RoleAdmin      = string(api.UserRoleAdmin)
+	RoleManager    = string(api.UserRoleManager)
+	RoleCashier    = string(api.UserRoleCashier)
+)
+
+// Role groups used by the permission matrix
+var (
+	allRoles     = []string{RoleSuperadmin, RoleAdmin, RoleManager, RoleCashier}
+	managerRoles = []string{RoleSuperadmin, RoleAdmin, RoleManager}
+	adminRoles   = []string{RoleSuperadmin, RoleAdmin}
+	superadmins  = []string{RoleSuperadmin}
+)
+
+// operationPermissions maps every authenticated operation to the roles allowed
+// to call it. Operations missing from the matrix are denied to everyone.
+var operationPermissions = map[api.OperationName][]string{
+	// Auth
+	api.LogoutOperation:            allRoles,
+	api.GetCurrentUserOperation:    allRoles,
+	api.ChangePasswordOperation:    allRoles,
+	api.EnrollMfaOperation:         allRoles,
+	api.ConfirmMfaOperation:        allRoles,
+	api.StopImpersonationOperation: allRoles,
+	api.ListApiKeysOperation:       allRoles,
+	api.CreateApiKeyOperation:      allRoles,
+	api.RevokeApiKeyOperation:      allRoles,
+
+	// Tasks
+	api.ListTasksOperation:  allRoles,
+	api.GetTaskOperation:    allRoles,
+	api.CreateTaskOperation: allRoles,
+	api.UpdateTaskOperation: allRoles,
+	api.DeleteTaskOperation: managerRoles,
+
+	// Users
+	api.ListUsersOperation:          managerRoles,
+	api.GetUserOperation:            managerRoles,
+	api.CreateUserOperation:         adminRoles,
+	api.UpdateUserOperation:         adminRoles,
+	api.DeleteUserOperation:         adminRoles,
+	api.InviteUserOperation:         adminRoles,
+	api.ListUserSessionsOperation:   adminRoles,
+	api.RevokeUserSessionOperation:  adminRoles,
+	api.RevokeUserSessionsOperation: adminRoles,
+	api.ListLoginHistoryOperation:   adminRoles,
+	api.ResendInvitationOperation:   adminRoles,
+	api.RevokeInvitationOperation:   adminRoles,
+	api.UnlockUserOperation:         adminRoles,
+	api.ImportUsersOperation:        adminRoles,
+	api.ExportUsersOperation:        adminRoles,
+	api.RestoreUserOperation:        adminRoles,
+	api.PurgeUserOperation:          superadmins,
+	api.BulkUserActionOperation:     adminRoles,
+	api.ResetUserMfaOperation:       superadmins,
+	api.ImpersonateUserOperation:    superadmins,
+
+	// Apps
+	api.ListAppsOperation:      allRoles,
+	api.ConnectAppOperation:    managerRoles,
+	api.DisconnectAppOperation: managerRoles,
+
+	// Chats
+	api.ListChatsOperation:   allRoles,
+	api.GetChatOperation:     allRoles,
+	api.SendMessageOperation: allRoles,
+
+	// Dashboard
+	api.GetDashboardStatsOperation:    allRoles,
+	api.GetDashboardOverviewOperation: allRoles,
+	api.GetRecentSalesOperation:       allRoles,
+}
+
+// API key scopes as defined by the ApiKeyScope schema
+const (
+	ScopeTasksRead     = string(api.ApiKeyScopeTasksRead)
+	ScopeTasksWrite    = string(api.ApiKeyScopeTasksWrite)
+	ScopeUsersRead     = string(api.ApiKeyScopeUsersRead)
+	ScopeUsersWrite    = string(api.ApiKeyScopeUsersWrite)
+	ScopeAppsRead      = string(api.ApiKeyScopeAppsRead)
+	ScopeAppsWrite     = string(api.ApiKeyScopeAppsWrite)
+	ScopeChatsRead     = string(api.ApiKeyScopeChatsRead)
+	ScopeChatsWrite    = string(api.ApiKeyScopeChatsWrite)
+	ScopeDashboardRead = string(api.ApiKeyScopeDashboardRead)
+)
+
+// operationScopes maps the operations available to API keys to the scope
+// they require. Account security operations (passwords, MFA, API keys and
+// logout) are left out so a leaked key cannot be used to entrench itself.
+var operationScopes = map[api.OperationName]string{
+	// Tasks
+	api.ListTasksOperation:  ScopeTasksRead,
+	api.GetTaskOperation:    ScopeTasksRead,
+	api.CreateTaskOperation: ScopeTasksWrite,
+	api.UpdateTaskOperation: ScopeTasksWrite,
+	api.DeleteTaskOperation: ScopeTasksWrite,
+
+	// Users
+	api.ListUsersOperation:          ScopeUsersRead,
+	api.GetUserOperation:            ScopeUsersRead,
+	api.ListUserSessionsOperation:   ScopeUsersRead,
+	api.ListLoginHistoryOperation:   ScopeUsersRead,
+	api.CreateUserOperation:         ScopeUsersWrite,
+	api.UpdateUserOperation:         ScopeUsersWrite,
+	api.DeleteUserOperation:         ScopeUsersWrite,
+	api.InviteUserOperation:         ScopeUsersWrite,
+	api.RevokeUserSessionOperation:  ScopeUsersWrite,
+	api.RevokeUserSessionsOperation: ScopeUsersWrite,
+	api.ResendInvitationOperation:   ScopeUsersWrite,
+	api.RevokeInvitationOperation:   ScopeUsersWrite,
+	api.UnlockUserOperation:         ScopeUsersWrite,
+	api.ImportUsersOperation:        ScopeUsersWrite,
+	api.ExportUsersOperation:        ScopeUsersRead,
+	api.RestoreUserOperation:        ScopeUsersWrite,
+	api.BulkUserActionOperation:     ScopeUsersWrite,
+
+	// Apps
+	api.ListAppsOperation:      ScopeAppsRead,
+	api.ConnectAppOperation:    ScopeAppsWrite,
+	api.DisconnectAppOperation: ScopeAppsWrite,
+
+	// Chats
+	api.ListChatsOperation:   ScopeChatsRead,
+	api.GetChatOperation:     ScopeChatsRead,
+	api.SendMessageOperation: ScopeChatsWrite,
+
+	// Dashboard
+	api.GetDashboardStatsOperation:    ScopeDashboardRead,
+	api.GetDashboardOverviewOperation: ScopeDashboardRead,
+	api.GetRecentSalesOperation:       ScopeDashboardRead,
+}
+
+// impersonationDenied lists the operations an impersonation token may not
+// call, so support staff cannot change the user's credentials or create
+// accounts with the user's access
+var impersonationDenied = []api.OperationName{
+	api.ChangePasswordOperation,
+	api.EnrollMfaOperation,
+	api.ConfirmMfaOperation,
+	api.CreateApiKeyOperation,
+	api.RevokeApiKeyOperation,
+	api.CreateUserOperation,
+	api.InviteUserOperation,
+	api.ImportUsersOperation,
+}
+
+// IsOperationAllowed reports whether the role may call the operation
+func IsOperationAllowed(operationName api.OperationName, role string) bool {
+	return slices.Contains(operationPermissions[operationName], role)
+}
+
+// IsOperationAllowedWhileImpersonating reports whether an impersonation token may call the operation
+func IsOperationAllowedWhileImpersonating(operationName api.OperationName) bool {
+	return !slices.Contains(impersonationDenied, operationName)
+}
+
+// IsOperationInScope reports whether the API key scopes grant the operation
+func IsOperationInScope(operationName api.OperationName, scopes []string) bool {
+	scope, ok := operationScopes[operationName]
+	return ok && slices.Contains(scopes, scope)
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Principal is the authenticated identity attached to a request context
+type Principal struct {
+	UserID    uuid.UUID
+	Role      string
+	TokenID   string
+	ExpiresAt time.Time
+	// APIKeyID and Scopes are set when the request used an API key instead
+	// of an access token; the key may only call operations in its scopes
+	APIKeyID uuid.UUID
+	Scopes   []string
+	// ImpersonatorID is the superadmin acting as UserID, if any
+	ImpersonatorID uuid.UUID
+}
+
+// principalContextKey is the context key for the authenticated Principal
+type principalContextKey struct{}
+
+// ContextWithPrincipal returns a copy of ctx carrying the authenticated principal
+func ContextWithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, p)
+}
+
+// PrincipalFromContext returns the authenticated principal, if any
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalContextKey{}).(*Principal)
+	return p, ok && p != nil
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+	"gorm.io/gorm"
+)
+
+// SessionService interface for managing a user's login sessions
+type SessionService interface {
+	List(ctx context.Context, params api.ListUserSessionsParams) (api.ListUserSessionsRes, error)
+	Revoke(ctx context.Context, params api.RevokeUserSessionParams) (api.RevokeUserSessionRes, error)
+	RevokeAll(ctx context.Context, params api.RevokeUserSessionsParams) (api.RevokeUserSessionsRes, error)
+}
+
+// sessionServiceImpl implements SessionService
+type sessionServiceImpl struct {
+	db *gorm.DB
+}
+
+// sessionServiceBuilder is the builder for SessionService
+type sessionServiceBuilder struct {
+	db *gorm.DB
+}
+
+// NewSessionService creates a new SessionService builder
+func NewSessionService(db *gorm.DB) *sessionServiceBuilder {
+	return &sessionServiceBuilder{db: db}
+}
+
+// Build creates the SessionService
+func (b *sessionServiceBuilder) Build() SessionService {
+	return &sessionServiceImpl{db: b.db}
+}
+
+// List implements SessionService
+func (s *sessionServiceImpl) List(ctx context.Context, params api.ListUserSessionsParams) (api.ListUserSessionsRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	userID, err := uuid.Parse(params.UserId)
+	if err != nil {
+		return &api.ListUserSessionsNotFound{}, nil
+	}
+	if err := s.db.WithContext(ctx).Select("id").Where("id = ?", userID).First(&models.User{}).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.ListUserSessionsNotFound{}, nil
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	var sessions []models.Session
+	if err := s.db.WithContext(ctx).
+		Where("user_id = ? AND expires_at > ?", userID, time.Now()).
+		Order("created_at DESC").
+		Find(&sessions).Error; err != nil {
+		return nil, fmt.Errorf("list sessions: %w", err)
+	}
+
+	data := make([]api.Session, len(sessions))
+	for i, sess := range sessions {
+		data[i] = sessionToAPI(sess)
+	}
+
+	return &api.SessionListResponse{Data: data}, nil
+}
+
+// Revoke implements SessionService
+func (s *sessionServiceImpl) Revoke(ctx context.Context, params api.RevokeUserSessionParams) (api.RevokeUserSessionRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	userID, err := uuid.Parse(params.UserId)
+	if err != nil {
+		return &api.RevokeUserSessionNotFound{}, nil
+	}
+
+	var user models.User
+	if err := s.db.WithContext(ctx).Select("id", "role").Where("id = ?", userID).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.RevokeUserSessionNotFound{}, nil
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+	if err := authorizeUserChange(ctx, user); err != nil {
+		return nil, err
+	}
+
+	var session models.Session
+	if err := s.db.WithContext(ctx).Where("id = ? AND user_id = ?", params.SessionId, userID).First(&session).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.RevokeUserSessionNotFound{}, nil
+		}
+		return nil, fmt.Errorf("get session: %w", err)
+	}
+
+	if err := revokeSession(ctx, s.db, session.ID); err != nil {
+		return nil, err
+	}
+
+	return &api.RevokeUserSessionNoContent{}, nil
+}
+
+// RevokeAll implements SessionService
+func (s *sessionServiceImpl) RevokeAll(ctx context.Context, params api.RevokeUserSessionsParams) (api.RevokeUserSessionsRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	userID, err := uuid.Parse(params.UserId)
+	if err != nil {
+		return &api.RevokeUserSessionsNotFound{}, nil
+	}
+	var user models.User
+	if err := s.db.WithContext(ctx).Select("id", "role").Where("id = ?", userID).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.RevokeUserSessionsNotFound{}, nil
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+	if err := authorizeUserChange(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if err := revokeUserSessions(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
+
+	return &api.RevokeUserSessionsNoContent{}, nil
+}
+
+// revokeSession deletes a session together with its refresh token family
+func revokeSession(ctx context.Context, db *gorm.DB, sessionID string) error {
+	if err := db.WithContext(ctx).Where("session_id = ?", sessionID).Delete(&models.RefreshToken{}).Error; err != nil {
+		return fmt.Errorf("revoke refresh tokens: %w", err)
+	}
+	if err := db.WithContext(ctx).Where("id = ?", sessionID).Delete(&models.Session{}).Error; err != nil {
+		return fmt.Errorf("revoke session: %w", err)
+	}
+	return nil
+}
+
+// revokeUserSessions deletes every session and refresh token belonging to the user
+func revokeUserSessions(ctx context.Context, db *gorm.DB, userID uuid.UUID) error {
+	if err := db.WithContext(ctx).Where("user_id = ?", userID).Delete(&models.RefreshToken{}).Error; err != nil {
+		return fmt.Errorf("revoke refresh tokens: %w", err)
+	}
+	if err := db.WithContext(ctx).Where("user_id = ?", userID).Delete(&models.Session{}).Error; err != nil {
+		return fmt.Errorf("revoke sessions: %w", err)
+	}
+	return nil
+}
+
+// sessionToAPI converts a models.Session to api.Session
+func sessionToAPI(s models.Session) api.Session {
+	return api.Session{
+		ID:        s.ID,
+		UserId:    s.UserID,
+		CreatedAt: s.CreatedAt,
+		ExpiresAt: s.ExpiresAt,
+	}
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// sortColumn is a column a list can be sorted by
+type sortColumn struct {
+	Name string
+	// Time marks timestamp columns, whose cursor values are decoded as times
+	Time bool
+}
+
+// sortField is one column of a requested ordering
+type sortField struct {
+	sortColumn
+	Desc bool
+}
+
+// idSortField orders by primary key. It ends every ordering so rows with equal
+// sort values keep the same order from page to page.
+var idSortField = sortField{sortColumn: sortColumn{Name: "id"}}
+
+// parseSort resolves sort fields such as "lastName" or "-createdAt" against the
+// whitelist of sortable fields and their columns, and appends the ID tiebreaker
+func parseSort(fields []string, columns map[string]sortColumn) ([]sortField, error) {
+	var result []sortField
+	seen := make(map[string]bool)
+	for _, field := range fields {
+		name, desc := strings.CutPrefix(strings.TrimSpace(field), "-")
+		column, ok := columns[name]
+		if !ok {
+			return nil, fmt.Errorf("sort by %q: %w", field, ErrInvalidSort)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("sort by %q more than once: %w", name, ErrInvalidSort)
+		}
+		seen[name] = true
+		result = append(result, sortField{sortColumn: column, Desc: desc})
+	}
+	return append(result, idSortField), nil
+}
+
+// applySort orders the query by the fields
+func applySort(query *gorm.DB, fields []sortField) *gorm.DB {
+	for _, f := range fields {
+		query = query.Order(clause.OrderByColumn{Column: clause.Column{Name: f.Name}, Desc: f.Desc})
+	}
+	return query
+}
+
+// reverseSort returns the fields with every direction flipped
+func reverseSort(fields []sortField) []sortField {
+	reversed := make([]sortField, len(fields))
+	for i, f := range fields {
+		reversed[i] = sortField{sortColumn: f.sortColumn, Desc: !f.Desc}
+	}
+	return reversed
+}
+
+// sortSpec describes the ordering, e.g. "last_name,-created_at,id"
+func sortSpec(fields []sortField) string {
+	parts := make([]string, len(fields))
+	for i, f := range fields {
+		parts[i] = f.Name
+		if f.Desc {
+			parts[i] = "-" + f.Name
+		}
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// checkRoleGrant refuses to give out the superadmin role unless the principal
+// is a superadmin, so admins cannot promote themselves or anyone else
+func checkRoleGrant(principal *Principal, role string) error {
+	if role == RoleSuperadmin && principal.Role != RoleSuperadmin {
+		return fmt.Errorf("grant superadmin as %s: %w", principal.Role, ErrForbidden)
+	}
+	return nil
+}
+
+// canChangeUser reports whether the principal may change the user; only
+// superadmins change superadmins
+func canChangeUser(principal *Principal, user models.User) bool {
+	return user.Role != RoleSuperadmin || principal.Role == RoleSuperadmin
+}
+
+// checkUserChange is canChangeUser as an error
+func checkUserChange(principal *Principal, user models.User) error {
+	if !canChangeUser(principal, user) {
+		return fmt.Errorf("change superadmin %s as %s: %w", user.ID, principal.Role, ErrForbidden)
+	}
+	return nil
+}
+
+// authorizeUserChange is checkUserChange for the principal in ctx
+func authorizeUserChange(ctx context.Context, user models.User) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return ErrUnauthorized
+	}
+	return checkUserChange(principal, user)
+}
+
+// lockSuperadmins locks every active superadmin until the transaction ends and
+// returns their IDs. Taking these locks before the target users' serialises
+// concurrent requests that could each remove a different superadmin.
+func lockSuperadmins(tx *gorm.DB) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := tx.Model(&models.User{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("role = ? AND status = ?", RoleSuperadmin, string(api.UserStatusActive)).
+		Order("id").Pluck("id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("lock superadmins: %w", err)
+	}
+	return ids, nil
+}
+
+// checkLastSuperadmin refuses a change that takes superadmin access from the
+// removed users when no other active superadmin would remain
+func checkLastSuperadmin(superadmins, removed []uuid.UUID) error {
+	affected := slices.ContainsFunc(superadmins, func(id uuid.UUID) bool { return slices.Contains(removed, id) })
+	remains := slices.ContainsFunc(superadmins, func(id uuid.UUID) bool { return !slices.Contains(removed, id) })
+	if affected && !remains {
+		return fmt.Errorf("remove %d superadmins: %w", len(superadmins), ErrLastSuperadmin)
+	}
+	return nil
+}
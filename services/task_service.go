@@ -10,6 +10,9 @@ import (
 	"gorm.io/gorm"
 )
 
+// taskSort lists the newest tasks first
+var taskSort = []sortField{{sortColumn: sortColumn{Name: "created_at", Time: true}, Desc: true}, idSortField}
+
 // TaskService interface for task operations
 type TaskService interface {
 	List(ctx context.Context, params api.ListTasksParams) (*api.TaskListResponse, error)
@@ -49,7 +52,6 @@ func (s *taskServiceImpl) List(ctx context.Context, params api.ListTasksParams)
 
 	page := params.Page.Or(1)
 	pageSize := params.PageSize.Or(10)
-	offset := (page - 1) * pageSize
 
 	query := s.db.WithContext(ctx).Model(&models.Task{})
 
@@ -74,13 +76,8 @@ func (s *taskServiceImpl) List(ctx context.Context, params api.ListTasksParams)
 		query = query.Where("title ILIKE ? OR id ILIKE ?", "%"+filter+"%", "%"+filter+"%")
 	}
 
-	var total int64
-	if err := query.Count(&total).Error; err != nil {
-		return nil, fmt.Errorf("count tasks: %w", err)
-	}
-
-	var tasks []models.Task
-	if err := query.Offset(offset).Limit(pageSize).Order("created_at DESC").Find(&tasks).Error; err != nil {
+	tasks, meta, cursors, err := listPage(query, taskSort, page, pageSize, params.Cursor.Or(""), taskSortValue)
+	if err != nil {
 		return nil, fmt.Errorf("list tasks: %w", err)
 	}
 
@@ -89,20 +86,7 @@ func (s *taskServiceImpl) List(ctx context.Context, params api.ListTasksParams)
 		data[i] = taskToAPI(t)
 	}
 
-	totalPages := int(total) / pageSize
-	if int(total)%pageSize > 0 {
-		totalPages++
-	}
-
-	return &api.TaskListResponse{
-		Data: data,
-		Meta: api.PaginationMeta{
-			Page:       page,
-			PageSize:   pageSize,
-			Total:      int(total),
-			TotalPages: totalPages,
-		},
-	}, nil
+	return &api.TaskListResponse{Data: data, Meta: meta, Cursors: api.NewOptPageCursors(cursors)}, nil
 }
 
 // Create implements TaskService
@@ -154,8 +138,7 @@ func (s *taskServiceImpl) Get(ctx context.Context, params api.GetTaskParams) (ap
 		return nil, fmt.Errorf("get task: %w", err)
 	}
 
-	result := taskToAPI(task)
-	return &result, nil
+	return &api.TaskHeaders{ETag: api.NewOptString(versionETag(task.Version)), Response: taskToAPI(task)}, nil
 }
 
 // Update implements TaskService
@@ -173,6 +156,9 @@ func (s *taskServiceImpl) Update(ctx context.Context, req *api.UpdateTaskRequest
 		}
 		return nil, fmt.Errorf("get task: %w", err)
 	}
+	if !ifMatchAllows(params.IfMatch, task.Version) {
+		return nil, fmt.Errorf("update task %s at version %d: %w", task.ID, task.Version, ErrPreconditionFailed)
+	}
 
 	updates := make(map[string]interface{})
 
@@ -199,8 +185,14 @@ func (s *taskServiceImpl) Update(ctx context.Context, req *api.UpdateTaskRequest
 	}
 
 	if len(updates) > 0 {
-		if err := s.db.WithContext(ctx).Model(&task).Updates(updates).Error; err != nil {
-			return nil, fmt.Errorf("update task: %w", err)
+		updates["version"] = gorm.Expr("version + 1")
+		// The version is checked again in case the task changed since it was loaded
+		result := whereIfMatch(s.db.WithContext(ctx).Model(&task), params.IfMatch).Updates(updates)
+		if result.Error != nil {
+			return nil, fmt.Errorf("update task: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil, fmt.Errorf("update task %s: %w", task.ID, ErrPreconditionFailed)
 		}
 	}
 
@@ -209,8 +201,7 @@ func (s *taskServiceImpl) Update(ctx context.Context, req *api.UpdateTaskRequest
 		return nil, fmt.Errorf("reload task: %w", err)
 	}
 
-	result := taskToAPI(task)
-	return &result, nil
+	return &api.TaskHeaders{ETag: api.NewOptString(versionETag(task.Version)), Response: taskToAPI(task)}, nil
 }
 
 // Delete implements TaskService
@@ -221,18 +212,34 @@ func (s *taskServiceImpl) Delete(ctx context.Context, params api.DeleteTaskParam
 	default:
 	}
 
-	result := s.db.WithContext(ctx).Where("id = ?", params.TaskId).Delete(&models.Task{})
+	result := whereIfMatch(s.db.WithContext(ctx).Where("id = ?", params.TaskId), params.IfMatch).Delete(&models.Task{})
 	if result.Error != nil {
 		return nil, fmt.Errorf("delete task: %w", result.Error)
 	}
 
 	if result.RowsAffected == 0 {
-		return &api.DeleteTaskNotFound{}, nil
+		// Either there is no such task or If-Match named another version
+		err := s.db.WithContext(ctx).Select("id").Where("id = ?", params.TaskId).First(&models.Task{}).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.DeleteTaskNotFound{}, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get task: %w", err)
+		}
+		return nil, fmt.Errorf("delete task %s: %w", params.TaskId, ErrPreconditionFailed)
 	}
 
 	return &api.DeleteTaskNoContent{}, nil
 }
 
+// taskSortValue returns the task's value for a sort column
+func taskSortValue(t models.Task, column string) any {
+	if column == "created_at" {
+		return t.CreatedAt
+	}
+	return t.ID
+}
+
 // taskToAPI converts a models.Task to api.Task
 func taskToAPI(t models.Task) api.Task {
 	result := api.Task{
@@ -243,6 +250,7 @@ func taskToAPI(t models.Task) api.Task {
 		Priority:  api.TaskPriority(t.Priority),
 		CreatedAt: api.NewOptDateTime(t.CreatedAt),
 		UpdatedAt: api.NewOptDateTime(t.UpdatedAt),
+		Version:   t.Version,
 	}
 
 	if t.Assignee != "" {
@@ -0,0 +1,291 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// minSigningKeyLength is the minimum accepted HMAC secret length in bytes
+const minSigningKeyLength = 32
+
+// defaultAccessTokenTTL is used when no TTL is configured on the builder
+const defaultAccessTokenTTL = 15 * time.Minute
+
+// TokenClaims are the claims carried by a signed access token
+type TokenClaims struct {
+	ID        string
+	UserID    uuid.UUID
+	Role      string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// TokenService issues and verifies signed access tokens
+type TokenService interface {
+	// Issue signs the claims with the active key. ID, IssuedAt and ExpiresAt
+	// are filled in when zero, and the completed claims are returned.
+	Issue(claims TokenClaims) (string, *TokenClaims, error)
+	// Verify checks the signature and expiry of a token and returns its claims
+	Verify(token string) (*TokenClaims, error)
+}
+
+// tokenHeader is the JOSE header of a signed token
+type tokenHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+	Kid string `json:"kid"`
+}
+
+// tokenPayload is the JSON payload of a signed token
+type tokenPayload struct {
+	ID        string `json:"jti"`
+	Subject   string `json:"sub"`
+	Role      string `json:"role"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// tokenServiceImpl implements TokenService using HS256 signed JWTs
+type tokenServiceImpl struct {
+	keys        map[string][]byte
+	activeKeyID string
+	ttl         time.Duration
+	now         func() time.Time
+}
+
+// tokenServiceBuilder is the builder for TokenService
+type tokenServiceBuilder struct {
+	keys        map[string][]byte
+	activeKeyID string
+	ttl         time.Duration
+	now         func() time.Time
+}
+
+// NewTokenService creates a new TokenService builder
+func NewTokenService() *tokenServiceBuilder {
+	return &tokenServiceBuilder{
+		keys: make(map[string][]byte),
+		ttl:  defaultAccessTokenTTL,
+		now:  time.Now,
+	}
+}
+
+// WithKey adds a signing key. The first key added becomes the active signing
+// key unless WithActiveKey is used; all keys are accepted for verification so
+// tokens signed with a retired key stay valid until they expire.
+func (b *tokenServiceBuilder) WithKey(id string, secret []byte) *tokenServiceBuilder {
+	if b.activeKeyID == "" {
+		b.activeKeyID = id
+	}
+	b.keys[id] = secret
+	return b
+}
+
+// WithActiveKey selects the key used to sign new tokens
+func (b *tokenServiceBuilder) WithActiveKey(id string) *tokenServiceBuilder {
+	b.activeKeyID = id
+	return b
+}
+
+// WithTTL sets the lifetime of issued tokens
+func (b *tokenServiceBuilder) WithTTL(ttl time.Duration) *tokenServiceBuilder {
+	b.ttl = ttl
+	return b
+}
+
+// WithClock overrides the time source (used by tests)
+func (b *tokenServiceBuilder) WithClock(now func() time.Time) *tokenServiceBuilder {
+	b.now = now
+	return b
+}
+
+// Build creates the TokenService
+func (b *tokenServiceBuilder) Build() (TokenService, error) {
+	if len(b.keys) == 0 {
+		return nil, errors.New("token service: no signing keys configured")
+	}
+	if _, ok := b.keys[b.activeKeyID]; !ok {
+		return nil, fmt.Errorf("token service: active key %q not configured", b.activeKeyID)
+	}
+	for id, secret := range b.keys {
+		if id == "" {
+			return nil, errors.New("token service: empty key id")
+		}
+		if len(secret) < minSigningKeyLength {
+			return nil, fmt.Errorf("token service: key %q must be at least %d bytes", id, minSigningKeyLength)
+		}
+	}
+	if b.ttl <= 0 {
+		return nil, errors.New("token service: ttl must be positive")
+	}
+
+	keys := make(map[string][]byte, len(b.keys))
+	for id, secret := range b.keys {
+		keys[id] = secret
+	}
+
+	return &tokenServiceImpl{
+		keys:        keys,
+		activeKeyID: b.activeKeyID,
+		ttl:         b.ttl,
+		now:         b.now,
+	}, nil
+}
+
+// ParseSigningKeys parses a comma separated list of "kid:secret" pairs as used
+// by the TOKEN_SIGNING_KEYS setting. The first key is the active one.
+func ParseSigningKeys(spec string) ([]string, map[string][]byte, error) {
+	var ids []string
+	keys := make(map[string][]byte)
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		id, secret, ok := strings.Cut(pair, ":")
+		if !ok || id == "" || secret == "" {
+			return nil, nil, fmt.Errorf("invalid signing key %q, expected kid:secret", pair)
+		}
+		if _, dup := keys[id]; dup {
+			return nil, nil, fmt.Errorf("duplicate signing key id %q", id)
+		}
+		ids = append(ids, id)
+		keys[id] = []byte(secret)
+	}
+	if len(ids) == 0 {
+		return nil, nil, errors.New("no signing keys configured")
+	}
+	return ids, keys, nil
+}
+
+// Issue implements TokenService
+func (s *tokenServiceImpl) Issue(claims TokenClaims) (string, *TokenClaims, error) {
+	now := s.now()
+	if claims.ID == "" {
+		id, err := randomTokenID()
+		if err != nil {
+			return "", nil, err
+		}
+		claims.ID = id
+	}
+	if claims.IssuedAt.IsZero() {
+		claims.IssuedAt = now
+	}
+	if claims.ExpiresAt.IsZero() {
+		claims.ExpiresAt = now.Add(s.ttl)
+	}
+
+	header, err := json.Marshal(tokenHeader{Alg: "HS256", Typ: "JWT", Kid: s.activeKeyID})
+	if err != nil {
+		return "", nil, fmt.Errorf("encode token header: %w", err)
+	}
+	payload, err := json.Marshal(tokenPayload{
+		ID:        claims.ID,
+		Subject:   claims.UserID.String(),
+		Role:      claims.Role,
+		IssuedAt:  claims.IssuedAt.Unix(),
+		ExpiresAt: claims.ExpiresAt.Unix(),
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("encode token payload: %w", err)
+	}
+
+	signingInput := encodeSegment(header) + "." + encodeSegment(payload)
+	signature := sign(s.keys[s.activeKeyID], signingInput)
+
+	return signingInput + "." + encodeSegment(signature), &claims, nil
+}
+
+// Verify implements TokenService
+func (s *tokenServiceImpl) Verify(token string) (*TokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed token: %w", ErrInvalidToken)
+	}
+
+	headerJSON, err := decodeSegment(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("decode token header: %w", ErrInvalidToken)
+	}
+	var header tokenHeader
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return nil, fmt.Errorf("parse token header: %w", ErrInvalidToken)
+	}
+	if header.Alg != "HS256" {
+		return nil, fmt.Errorf("unsupported token algorithm %q: %w", header.Alg, ErrInvalidToken)
+	}
+	key, ok := s.keys[header.Kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q: %w", header.Kid, ErrInvalidToken)
+	}
+
+	signature, err := decodeSegment(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("decode token signature: %w", ErrInvalidToken)
+	}
+	if !hmac.Equal(signature, sign(key, parts[0]+"."+parts[1])) {
+		return nil, fmt.Errorf("bad token signature: %w", ErrInvalidToken)
+	}
+
+	payloadJSON, err := decodeSegment(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("decode token payload: %w", ErrInvalidToken)
+	}
+	var payload tokenPayload
+	if err := json.Unmarshal(payloadJSON, &payload); err != nil {
+		return nil, fmt.Errorf("parse token payload: %w", ErrInvalidToken)
+	}
+	userID, err := uuid.Parse(payload.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("parse token subject: %w", ErrInvalidToken)
+	}
+
+	claims := &TokenClaims{
+		ID:        payload.ID,
+		UserID:    userID,
+		Role:      payload.Role,
+		IssuedAt:  time.Unix(payload.IssuedAt, 0),
+		ExpiresAt: time.Unix(payload.ExpiresAt, 0),
+	}
+	if !s.now().Before(claims.ExpiresAt) {
+		return nil, fmt.Errorf("token expired at %s: %w", claims.ExpiresAt.UTC().Format(time.RFC3339), ErrTokenExpired)
+	}
+
+	return claims, nil
+}
+
+// sign computes the HMAC-SHA256 of the signing input
+func sign(key []byte, signingInput string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(signingInput))
+	return mac.Sum(nil)
+}
+
+// encodeSegment base64url encodes a token segment without padding
+func encodeSegment(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeSegment decodes a base64url token segment without padding
+func decodeSegment(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(s)
+}
+
+// randomTokenID generates a random 128-bit token identifier
+func randomTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate token id: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
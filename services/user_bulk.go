@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BulkAction implements UserService
+func (s *userServiceImpl) BulkAction(ctx context.Context, req *api.BulkUserActionRequest) (api.BulkUserActionRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+
+	// removesSuperadmin is set when the action leaves a superadmin unable to
+	// act as one; signsOut when the users can no longer sign in
+	updates := make(map[string]any)
+	var removesSuperadmin, signsOut bool
+	switch req.Action {
+	case api.BulkUserActionSetStatus:
+		status, ok := req.Status.Get()
+		if !ok {
+			return nil, fmt.Errorf("setStatus without status: %w", ErrInvalidBulkAction)
+		}
+		// Invited is only reached through the invitation flow
+		if status == api.UserStatusInvited {
+			return nil, fmt.Errorf("set status %s: %w", status, ErrInvalidBulkAction)
+		}
+		updates["status"] = string(status)
+		removesSuperadmin = status != api.UserStatusActive
+		signsOut = status != api.UserStatusActive
+	case api.BulkUserActionSetRole:
+		role, ok := req.Role.Get()
+		if !ok {
+			return nil, fmt.Errorf("setRole without role: %w", ErrInvalidBulkAction)
+		}
+		// Support staff acting as a user must not change anyone's access
+		if principal.ImpersonatorID != uuid.Nil {
+			return nil, fmt.Errorf("change roles while impersonated by %s: %w", principal.ImpersonatorID, ErrForbidden)
+		}
+		if err := checkRoleGrant(principal, string(role)); err != nil {
+			return nil, err
+		}
+		updates["role"] = string(role)
+		removesSuperadmin = role != api.UserRoleSuperadmin
+	case api.BulkUserActionDelete:
+		removesSuperadmin = true
+		signsOut = true
+	}
+
+	var ids []uuid.UUID
+	for _, id := range req.UserIds {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	results := make(map[uuid.UUID]api.BulkUserActionResult, len(ids))
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var superadmins []uuid.UUID
+		if removesSuperadmin {
+			var err error
+			if superadmins, err = lockSuperadmins(tx); err != nil {
+				return err
+			}
+		}
+
+		var users []models.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id IN ?", ids).Order("id").Find(&users).Error; err != nil {
+			return fmt.Errorf("find users: %w", err)
+		}
+
+		var eligible []uuid.UUID
+		for _, u := range users {
+			if !canChangeUser(principal, u) {
+				results[u.ID] = api.BulkUserActionResult{
+					UserId: u.ID,
+					Result: api.BulkUserActionOutcomeForbidden,
+					Reason: api.NewOptString("Only superadmins can change superadmins"),
+				}
+				continue
+			}
+			eligible = append(eligible, u.ID)
+			results[u.ID] = api.BulkUserActionResult{UserId: u.ID, Result: api.BulkUserActionOutcomeUpdated}
+		}
+		if len(eligible) == 0 {
+			return nil
+		}
+
+		if removesSuperadmin {
+			if err := checkLastSuperadmin(superadmins, eligible); err != nil {
+				return fmt.Errorf("%s %d users: %w", req.Action, len(eligible), err)
+			}
+		}
+
+		if req.Action == api.BulkUserActionDelete {
+			if err := softDeleteUsers(tx.Where("id IN ?", eligible)).Error; err != nil {
+				return fmt.Errorf("delete users: %w", err)
+			}
+		} else {
+			updates["version"] = gorm.Expr("version + 1")
+			if err := tx.Model(&models.User{}).Where("id IN ?", eligible).Updates(updates).Error; err != nil {
+				return fmt.Errorf("update users: %w", err)
+			}
+		}
+
+		// As with single updates and deletes, their sessions end immediately
+		if signsOut {
+			for _, id := range eligible {
+				if err := revokeUserSessions(ctx, tx, id); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &api.BulkUserActionResponse{Results: make([]api.BulkUserActionResult, len(ids))}
+	for i, id := range ids {
+		result, ok := results[id]
+		if !ok {
+			result = api.BulkUserActionResult{UserId: id, Result: api.BulkUserActionOutcomeNotFound}
+		}
+		response.Results[i] = result
+	}
+	return response, nil
+}
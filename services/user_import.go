@@ -0,0 +1,401 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"slices"
+	"strings"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+	"gorm.io/gorm"
+)
+
+// maxImportRows bounds the number of users a single import may create
+const maxImportRows = 1000
+
+// importColumns are the CSV columns a user import may contain
+var importColumns = []string{"email", "username", "firstName", "lastName", "phoneNumber", "role", "status", "password"}
+
+// ImportFileError rejects an import file that cannot be read as a user CSV
+type ImportFileError struct {
+	Reason string
+}
+
+// Error implements error
+func (e *ImportFileError) Error() string {
+	return ErrInvalidImport.Error() + ": " + e.Reason
+}
+
+// Unwrap lets errors.Is match ErrInvalidImport
+func (e *ImportFileError) Unwrap() error {
+	return ErrInvalidImport
+}
+
+// FieldViolations returns the reason for the error response
+func (e *ImportFileError) FieldViolations() []FieldViolation {
+	return []FieldViolation{{Field: "file", Code: "INVALID_CSV", Message: e.Reason}}
+}
+
+// importRecord is a data row read from the CSV
+type importRecord struct {
+	line   int
+	values map[string]string
+	// problem is set when the row cannot be read as a user, e.g. it has the wrong number of fields
+	problem string
+}
+
+// importMail is a message for an imported user, sent after the import commits
+type importMail struct {
+	line int
+	msg  MailMessage
+}
+
+// importRow is a CSV row that passed validation
+type importRow struct {
+	line     int
+	user     models.User
+	password string
+}
+
+// Import implements UserService
+func (s *userServiceImpl) Import(ctx context.Context, req *api.ImportUsersReq) (api.ImportUsersRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+
+	invite := req.SendInvitations.Or(false)
+	report := &api.UserImportReport{DryRun: req.DryRun.Or(false), Errors: []api.UserImportError{}}
+	addError := func(line int, field, message string) {
+		e := api.UserImportError{Row: line, Message: message}
+		if field != "" {
+			e.Field = api.NewOptString(field)
+		}
+		report.Errors = append(report.Errors, e)
+	}
+
+	records, err := readImportFile(req.File.File)
+	if err != nil {
+		return nil, err
+	}
+	report.Total = len(records)
+
+	var rows []importRow
+	for _, record := range records {
+		if row, ok := s.validateImportRow(record, invite, principal, addError); ok {
+			rows = append(rows, row)
+		}
+	}
+	rows = rejectDuplicateImportRows(rows, addError)
+	if rows, err = s.rejectExistingImportRows(ctx, rows, addError); err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(report.Errors, func(a, b api.UserImportError) int { return a.Row - b.Row })
+
+	report.Valid = len(rows)
+	if report.DryRun || len(rows) == 0 {
+		return report, nil
+	}
+
+	// Hashing is slow, so it is done before the transaction is opened
+	for i := range rows {
+		if rows[i].password == "" {
+			continue
+		}
+		if rows[i].user.Password, err = s.policy.hash(rows[i].password); err != nil {
+			return nil, err
+		}
+	}
+
+	// Rows with a chosen username go first so a generated one cannot take it
+	var order, generated []int
+	for i := range rows {
+		if rows[i].user.Username == "" {
+			generated = append(generated, i)
+		} else {
+			order = append(order, i)
+		}
+	}
+	order = append(order, generated...)
+
+	// Links are only mailed once the transaction commits, so a failed import
+	// sends nothing and SMTP round trips do not hold the transaction open
+	var mails []importMail
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, i := range order {
+			user := &rows[i].user
+			if err := insertUser(tx, user); err != nil {
+				return fmt.Errorf("import row %d: %w", rows[i].line, err)
+			}
+
+			var (
+				msg MailMessage
+				err error
+			)
+			switch {
+			case invite:
+				msg, err = s.invitations.prepare(tx, *user)
+			case user.Password == "":
+				msg, err = s.resets.prepare(tx, *user, defaultInvitationTTL, passwordSetupSubject, passwordSetupBody)
+			default:
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			mails = append(mails, importMail{line: rows[i].line, msg: msg})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The users exist now, so a failed delivery is reported against its row;
+	// the admin can resend the invitation or have the user reset the password
+	for _, m := range mails {
+		if err := s.mailer.Send(ctx, m.msg); err != nil {
+			addError(m.line, "email", "The user was created but the email could not be sent")
+		}
+	}
+	slices.SortStableFunc(report.Errors, func(a, b api.UserImportError) int { return a.Row - b.Row })
+
+	report.Created = len(rows)
+	for _, row := range rows {
+		report.Users = append(report.Users, userToAPI(row.user))
+	}
+	return report, nil
+}
+
+// readImportFile parses the CSV into records keyed by canonical column name
+func readImportFile(r io.Reader) ([]importRecord, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, &ImportFileError{Reason: "the file is empty"}
+		}
+		return nil, &ImportFileError{Reason: err.Error()}
+	}
+
+	// Column names are matched case-insensitively; spreadsheets often add a BOM
+	columns := make([]string, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		j := slices.IndexFunc(importColumns, func(c string) bool { return strings.EqualFold(c, name) })
+		if j < 0 {
+			return nil, &ImportFileError{Reason: fmt.Sprintf("unknown column %q", name)}
+		}
+		if slices.Contains(columns, importColumns[j]) {
+			return nil, &ImportFileError{Reason: fmt.Sprintf("column %q appears more than once", name)}
+		}
+		columns[i] = importColumns[j]
+	}
+	for _, required := range []string{"email", "role"} {
+		if !slices.Contains(columns, required) {
+			return nil, &ImportFileError{Reason: fmt.Sprintf("missing required column %q", required)}
+		}
+	}
+
+	var records []importRecord
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, &ImportFileError{Reason: err.Error()}
+		}
+		if len(records) == maxImportRows {
+			return nil, &ImportFileError{Reason: fmt.Sprintf("the file has more than %d rows", maxImportRows)}
+		}
+
+		record := importRecord{values: make(map[string]string)}
+		record.line, _ = reader.FieldPos(0)
+		if len(fields) != len(columns) {
+			// Reported against the row rather than rejecting the whole file
+			record.problem = fmt.Sprintf("Expected %d fields, got %d", len(columns), len(fields))
+		}
+		for i, value := range fields {
+			if i < len(columns) {
+				record.values[columns[i]] = strings.TrimSpace(value)
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// validateImportRow checks a record's values and builds the user it describes
+func (s *userServiceImpl) validateImportRow(record importRecord, invite bool, principal *Principal, addError func(line int, field, message string)) (importRow, bool) {
+	valid := true
+	fail := func(field, message string) {
+		addError(record.line, field, message)
+		valid = false
+	}
+	if record.problem != "" {
+		fail("", record.problem)
+	}
+	values := record.values
+
+	email := values["email"]
+	if email == "" {
+		fail("email", "Required")
+	} else if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+		fail("email", "Invalid email address")
+	}
+
+	// Rows without a username get one generated from the email when inserted
+	username := values["username"]
+	if username != "" && !usernamePattern.MatchString(username) {
+		fail("username", "Use up to 64 letters, digits, dots, underscores or hyphens")
+	}
+
+	role := api.UserRole(values["role"])
+	if role == "" {
+		fail("role", "Required")
+	} else if err := role.Validate(); err != nil {
+		fail("role", fmt.Sprintf("Unknown role %q", role))
+	} else if err := checkRoleGrant(principal, string(role)); err != nil {
+		fail("role", "Only superadmins can grant the superadmin role")
+	}
+
+	status := api.UserStatusActive
+	if invite {
+		status = api.UserStatusInvited
+	}
+	if value := values["status"]; value != "" {
+		status = api.UserStatus(value)
+		switch {
+		case invite:
+			fail("status", "Status cannot be set when sending invitations")
+		case status.Validate() != nil:
+			fail("status", fmt.Sprintf("Unknown status %q", value))
+		case status == api.UserStatusInvited:
+			fail("status", "Use sendInvitations to invite users")
+		}
+	}
+
+	// Invitees enter their own names when accepting
+	if !invite {
+		for _, field := range []string{"firstName", "lastName"} {
+			if values[field] == "" {
+				fail(field, "Required")
+			}
+		}
+	}
+
+	password := values["password"]
+	if password != "" {
+		if invite {
+			fail("password", "Password cannot be set when sending invitations")
+		} else if err := s.policy.Validate("password", password); err != nil {
+			var policyErr *PasswordPolicyError
+			if !errors.As(err, &policyErr) {
+				fail("password", err.Error())
+			} else {
+				for _, v := range policyErr.Violations {
+					fail("password", v.Message)
+				}
+			}
+		}
+	}
+
+	if !valid {
+		return importRow{}, false
+	}
+	return importRow{
+		line: record.line,
+		user: models.User{
+			FirstName:   values["firstName"],
+			LastName:    values["lastName"],
+			Username:    username,
+			Email:       email,
+			PhoneNumber: values["phoneNumber"],
+			Role:        string(role),
+			Status:      string(status),
+		},
+		password: password,
+	}, true
+}
+
+// rejectDuplicateImportRows reports rows repeating the email or chosen username
+// of an earlier row in the file and returns the rest
+func rejectDuplicateImportRows(rows []importRow, addError func(line int, field, message string)) []importRow {
+	emails := make(map[string]int)
+	usernames := make(map[string]int)
+	var kept []importRow
+	for _, row := range rows {
+		email := strings.ToLower(row.user.Email)
+		if first, ok := emails[email]; ok {
+			addError(row.line, "email", fmt.Sprintf("Duplicate of row %d", first))
+			continue
+		}
+		if first, ok := usernames[row.user.Username]; ok && row.user.Username != "" {
+			addError(row.line, "username", fmt.Sprintf("Username %q is also used by row %d", row.user.Username, first))
+			continue
+		}
+		emails[email] = row.line
+		if row.user.Username != "" {
+			usernames[row.user.Username] = row.line
+		}
+		kept = append(kept, row)
+	}
+	return kept
+}
+
+// rejectExistingImportRows reports rows whose email or chosen username is
+// already taken by an existing user and returns the rest
+func (s *userServiceImpl) rejectExistingImportRows(ctx context.Context, rows []importRow, addError func(line int, field, message string)) ([]importRow, error) {
+	if len(rows) == 0 {
+		return rows, nil
+	}
+	emails := make([]string, len(rows))
+	var usernames []string
+	for i, row := range rows {
+		emails[i] = strings.ToLower(row.user.Email)
+		if row.user.Username != "" {
+			usernames = append(usernames, row.user.Username)
+		}
+	}
+
+	// Deleted users keep their email and username until they are purged
+	var existing []models.User
+	if err := s.db.WithContext(ctx).Unscoped().Select("email", "username").
+		Where("LOWER(email) IN ? OR username IN ?", emails, usernames).
+		Find(&existing).Error; err != nil {
+		return nil, fmt.Errorf("find existing users: %w", err)
+	}
+	takenEmails := make(map[string]bool)
+	takenUsernames := make(map[string]bool)
+	for _, u := range existing {
+		takenEmails[strings.ToLower(u.Email)] = true
+		takenUsernames[u.Username] = true
+	}
+
+	var kept []importRow
+	for _, row := range rows {
+		switch {
+		case takenEmails[strings.ToLower(row.user.Email)]:
+			addError(row.line, "email", ErrDuplicateEmail.Error())
+		case row.user.Username != "" && takenUsernames[row.user.Username]:
+			addError(row.line, "username", ErrDuplicateUsername.Error())
+		default:
+			kept = append(kept, row)
+		}
+	}
+	return kept, nil
+}
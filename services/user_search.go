@@ -0,0 +1,64 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+	"gorm.io/gorm"
+)
+
+// userSearchDocument is the text free-text user search matches against. The
+// trigram index is built on exactly this expression so ILIKE can use it.
+const userSearchDocument = "(first_name || ' ' || last_name || ' ' || email || ' ' || username || ' ' || COALESCE(phone_number, ''))"
+
+// userSearchRank orders matches: exact email, then exact username or phone,
+// then a prefix of any name field, then any other match
+const userSearchRank = `CASE
+	WHEN LOWER(email) = LOWER(@q) THEN 0
+	WHEN LOWER(username) = LOWER(@q) OR phone_number = @q THEN 1
+	WHEN first_name ILIKE @prefix OR last_name ILIKE @prefix OR email ILIKE @prefix OR username ILIKE @prefix THEN 2
+	ELSE 3
+END`
+
+// searchRankSortField orders search results by rank ahead of the requested sort
+var searchRankSortField = sortField{sortColumn: sortColumn{Name: "search_rank"}}
+
+// rankedUser is a user matched by a free-text search
+type rankedUser struct {
+	models.User
+	SearchRank int
+}
+
+// searchUsers returns the users matching q with their rank as a search_rank
+// column, for use as the table of a list query
+func searchUsers(db *gorm.DB, q string) *gorm.DB {
+	escaped := escapeLike(q)
+	return db.Model(&models.User{}).
+		Select("users.*, "+userSearchRank+" AS search_rank", map[string]any{"q": q, "prefix": escaped + "%"}).
+		Where(userSearchDocument+" ILIKE ?", "%"+escaped+"%")
+}
+
+// rankedUserSortValue returns the search result's value for a sort column
+func rankedUserSortValue(u rankedUser, column string) any {
+	if column == searchRankSortField.Name {
+		return u.SearchRank
+	}
+	return userSortValue(u.User, column)
+}
+
+// escapeLike escapes the LIKE wildcards in s so it matches literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// createUserSearchIndex adds the trigram index backing free-text user search
+func createUserSearchIndex(db *gorm.DB) error {
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error; err != nil {
+		return fmt.Errorf("create pg_trgm extension: %w", err)
+	}
+	if err := db.Exec("CREATE INDEX IF NOT EXISTS idx_users_search ON users USING gin (" + userSearchDocument + " gin_trgm_ops)").Error; err != nil {
+		return fmt.Errorf("create user search index: %w", err)
+	}
+	return nil
+}
@@ -4,14 +4,33 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
 	"github.com/sunfmin/shadcn-admin-go/internal/models"
-	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// userSortColumns maps the sortable user list fields to their columns
+var userSortColumns = map[string]sortColumn{
+	"firstName":   {Name: "first_name"},
+	"lastName":    {Name: "last_name"},
+	"username":    {Name: "username"},
+	"email":       {Name: "email"},
+	"phoneNumber": {Name: "phone_number"},
+	"status":      {Name: "status"},
+	"role":        {Name: "role"},
+	"createdAt":   {Name: "created_at", Time: true},
+	"updatedAt":   {Name: "updated_at", Time: true},
+}
+
+// defaultUserSort lists the newest users first
+var defaultUserSort = []string{"-createdAt"}
+
 // UserService interface for user operations
 type UserService interface {
 	List(ctx context.Context, params api.ListUsersParams) (*api.UserListResponse, error)
@@ -20,26 +39,64 @@ type UserService interface {
 	Update(ctx context.Context, req *api.UpdateUserRequest, params api.UpdateUserParams) (api.UpdateUserRes, error)
 	Delete(ctx context.Context, params api.DeleteUserParams) (api.DeleteUserRes, error)
 	Invite(ctx context.Context, req *api.InviteUserRequest) (*api.User, error)
+	Unlock(ctx context.Context, params api.UnlockUserParams) (api.UnlockUserRes, error)
+	Import(ctx context.Context, req *api.ImportUsersReq) (api.ImportUsersRes, error)
+	Export(ctx context.Context, params api.ExportUsersParams) (api.ExportUsersRes, error)
+	Restore(ctx context.Context, params api.RestoreUserParams) (api.RestoreUserRes, error)
+	Purge(ctx context.Context, params api.PurgeUserParams) (api.PurgeUserRes, error)
+	BulkAction(ctx context.Context, req *api.BulkUserActionRequest) (api.BulkUserActionRes, error)
 }
 
 // userServiceImpl implements UserService
 type userServiceImpl struct {
-	db *gorm.DB
+	db          *gorm.DB
+	policy      PasswordPolicy
+	mailer      Mailer
+	invitations invitationSender
+	resets      passwordResetSender
 }
 
 // userServiceBuilder is the builder for UserService
 type userServiceBuilder struct {
-	db *gorm.DB
+	db     *gorm.DB
+	policy PasswordPolicy
+	mailer Mailer
+	appURL string
 }
 
 // NewUserService creates a new UserService builder
 func NewUserService(db *gorm.DB) *userServiceBuilder {
-	return &userServiceBuilder{db: db}
+	return &userServiceBuilder{db: db, policy: DefaultPasswordPolicy(), mailer: unconfiguredMailer{}, appURL: defaultAppURL}
+}
+
+// WithPasswordPolicy sets the rules initial passwords must satisfy
+func (b *userServiceBuilder) WithPasswordPolicy(policy PasswordPolicy) *userServiceBuilder {
+	b.policy = policy
+	return b
+}
+
+// WithMailer sets the mailer used to deliver invitation links. Without
+// one, sending fails.
+func (b *userServiceBuilder) WithMailer(mailer Mailer) *userServiceBuilder {
+	b.mailer = mailer
+	return b
+}
+
+// WithAppURL sets the frontend base URL used in emailed links
+func (b *userServiceBuilder) WithAppURL(appURL string) *userServiceBuilder {
+	b.appURL = appURL
+	return b
 }
 
 // Build creates the UserService
 func (b *userServiceBuilder) Build() UserService {
-	return &userServiceImpl{db: b.db}
+	return &userServiceImpl{
+		db:          b.db,
+		policy:      b.policy,
+		mailer:      b.mailer,
+		invitations: invitationSender{mailer: b.mailer, appURL: b.appURL, ttl: defaultInvitationTTL},
+		resets:      passwordResetSender{mailer: b.mailer, appURL: b.appURL},
+	}
 }
 
 // List implements UserService
@@ -52,38 +109,45 @@ func (s *userServiceImpl) List(ctx context.Context, params api.ListUsersParams)
 
 	page := params.Page.Or(1)
 	pageSize := params.PageSize.Or(10)
-	offset := (page - 1) * pageSize
 
-	query := s.db.WithContext(ctx).Model(&models.User{})
-
-	// Apply filters
-	if len(params.Status) > 0 {
-		statuses := make([]string, len(params.Status))
-		for i, st := range params.Status {
-			statuses[i] = string(st)
-		}
-		query = query.Where("status IN ?", statuses)
+	sortBy := params.Sort
+	if len(sortBy) == 0 {
+		sortBy = defaultUserSort
 	}
-
-	if len(params.Role) > 0 {
-		roles := make([]string, len(params.Role))
-		for i, r := range params.Role {
-			roles[i] = string(r)
-		}
-		query = query.Where("role IN ?", roles)
+	sort, err := parseSort(sortBy, userSortColumns)
+	if err != nil {
+		return nil, err
 	}
 
-	if username, ok := params.Username.Get(); ok && username != "" {
-		query = query.Where("username ILIKE ?", "%"+username+"%")
+	db, err := scopeDeletedUsers(ctx, s.db.WithContext(ctx), params.IncludeDeleted.Or(false))
+	if err != nil {
+		return nil, err
 	}
+	query := db.Model(&models.User{})
 
-	var total int64
-	if err := query.Count(&total).Error; err != nil {
-		return nil, fmt.Errorf("count users: %w", err)
+	// Searches list the ranked matches instead of the whole table
+	search := strings.TrimSpace(params.Q.Or(""))
+	if search != "" {
+		query = db.Table("(?) AS users", searchUsers(db, search))
 	}
 
-	var users []models.User
-	if err := query.Offset(offset).Limit(pageSize).Find(&users).Error; err != nil {
+	query = filterUsers(query, params.Status, params.Role, params.Username)
+
+	var (
+		users   []models.User
+		meta    api.PaginationMeta
+		cursors api.PageCursors
+	)
+	if search != "" {
+		var ranked []rankedUser
+		ranked, meta, cursors, err = listPage(query, append([]sortField{searchRankSortField}, sort...), page, pageSize, params.Cursor.Or(""), rankedUserSortValue)
+		for _, r := range ranked {
+			users = append(users, r.User)
+		}
+	} else {
+		users, meta, cursors, err = listPage(query, sort, page, pageSize, params.Cursor.Or(""), userSortValue)
+	}
+	if err != nil {
 		return nil, fmt.Errorf("list users: %w", err)
 	}
 
@@ -92,20 +156,45 @@ func (s *userServiceImpl) List(ctx context.Context, params api.ListUsersParams)
 		data[i] = userToAPI(u)
 	}
 
-	totalPages := int(total) / pageSize
-	if int(total)%pageSize > 0 {
-		totalPages++
+	return &api.UserListResponse{Data: data, Meta: meta, Cursors: api.NewOptPageCursors(cursors)}, nil
+}
+
+// scopeDeletedUsers widens db to include deleted users when requested, which
+// only admins may do
+func scopeDeletedUsers(ctx context.Context, db *gorm.DB, includeDeleted bool) (*gorm.DB, error) {
+	if !includeDeleted {
+		return db, nil
+	}
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok || !slices.Contains(adminRoles, principal.Role) {
+		return nil, fmt.Errorf("include deleted users: %w", ErrForbidden)
+	}
+	return db.Unscoped(), nil
+}
+
+// filterUsers applies the status, role and username filters shared by the
+// user list and export
+func filterUsers(query *gorm.DB, status []api.UserStatus, role []api.UserRole, username api.OptString) *gorm.DB {
+	if len(status) > 0 {
+		statuses := make([]string, len(status))
+		for i, st := range status {
+			statuses[i] = string(st)
+		}
+		query = query.Where("status IN ?", statuses)
+	}
+
+	if len(role) > 0 {
+		roles := make([]string, len(role))
+		for i, r := range role {
+			roles[i] = string(r)
+		}
+		query = query.Where("role IN ?", roles)
 	}
 
-	return &api.UserListResponse{
-		Data: data,
-		Meta: api.PaginationMeta{
-			Page:       page,
-			PageSize:   pageSize,
-			Total:      int(total),
-			TotalPages: totalPages,
-		},
-	}, nil
+	if name, ok := username.Get(); ok && name != "" {
+		query = query.Where("username ILIKE ?", "%"+name+"%")
+	}
+	return query
 }
 
 // Create implements UserService
@@ -116,21 +205,33 @@ func (s *userServiceImpl) Create(ctx context.Context, req *api.CreateUserRequest
 	default:
 	}
 
-	// Generate username from email
-	username := strings.Split(req.Email, "@")[0]
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+	if err := checkRoleGrant(principal, string(req.Role)); err != nil {
+		return nil, err
+	}
 
-	// Generate a default password (in production, send email to set password)
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("changeme123"), bcrypt.DefaultCost)
-	if err != nil {
-		return nil, fmt.Errorf("hash password: %w", err)
+	// Without an initial password the stored hash is empty and never matches,
+	// so the account stays unusable until the emailed link is followed
+	var hashedPassword string
+	if password, ok := req.Password.Get(); ok {
+		if err := s.policy.Validate("password", password); err != nil {
+			return nil, err
+		}
+		var err error
+		if hashedPassword, err = s.policy.hash(password); err != nil {
+			return nil, err
+		}
 	}
 
 	user := &models.User{
 		FirstName: req.FirstName,
 		LastName:  req.LastName,
-		Username:  username,
+		Username:  req.Username.Or(""),
 		Email:     req.Email,
-		Password:  string(hashedPassword),
+		Password:  hashedPassword,
 		Role:      string(req.Role),
 		Status:    "active",
 	}
@@ -139,17 +240,36 @@ func (s *userServiceImpl) Create(ctx context.Context, req *api.CreateUserRequest
 		user.PhoneNumber = phone
 	}
 
-	if err := s.db.WithContext(ctx).Create(user).Error; err != nil {
-		if isDuplicateKeyError(err) {
-			return nil, fmt.Errorf("create user: %w", ErrDuplicateEmail)
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := insertUser(tx, user); err != nil {
+			return fmt.Errorf("create user: %w", err)
+		}
+		if hashedPassword != "" {
+			return nil
 		}
-		return nil, fmt.Errorf("create user: %w", err)
+		return s.sendPasswordSetup(ctx, tx, *user)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	result := userToAPI(*user)
 	return &result, nil
 }
 
+// Password setup mail for users created without a password
+const (
+	passwordSetupSubject = "Set your Shadcn Admin password"
+	passwordSetupBody    = "An account has been created for you on Shadcn Admin.\n\n" +
+		"Choose your password here:\n%s\n\n" +
+		"This link expires on %s."
+)
+
+// sendPasswordSetup emails a user created without a password a link to choose one
+func (s *userServiceImpl) sendPasswordSetup(ctx context.Context, tx *gorm.DB, user models.User) error {
+	return s.resets.send(ctx, tx, user, defaultInvitationTTL, passwordSetupSubject, passwordSetupBody)
+}
+
 // Get implements UserService
 func (s *userServiceImpl) Get(ctx context.Context, params api.GetUserParams) (api.GetUserRes, error) {
 	select {
@@ -158,16 +278,20 @@ func (s *userServiceImpl) Get(ctx context.Context, params api.GetUserParams) (ap
 	default:
 	}
 
+	db, err := scopeDeletedUsers(ctx, s.db.WithContext(ctx), params.IncludeDeleted.Or(false))
+	if err != nil {
+		return nil, err
+	}
+
 	var user models.User
-	if err := s.db.WithContext(ctx).Where("id = ?", params.UserId).First(&user).Error; err != nil {
+	if err := db.Where("id = ?", params.UserId).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return &api.GetUserNotFound{}, nil
 		}
 		return nil, fmt.Errorf("get user: %w", err)
 	}
 
-	result := userToAPI(user)
-	return &result, nil
+	return &api.UserHeaders{ETag: api.NewOptString(versionETag(user.Version)), Response: userToAPI(user)}, nil
 }
 
 // Update implements UserService
@@ -178,6 +302,11 @@ func (s *userServiceImpl) Update(ctx context.Context, req *api.UpdateUserRequest
 	default:
 	}
 
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+
 	var user models.User
 	if err := s.db.WithContext(ctx).Where("id = ?", params.UserId).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -185,6 +314,12 @@ func (s *userServiceImpl) Update(ctx context.Context, req *api.UpdateUserRequest
 		}
 		return nil, fmt.Errorf("get user: %w", err)
 	}
+	if err := checkUserChange(principal, user); err != nil {
+		return nil, err
+	}
+	if !ifMatchAllows(params.IfMatch, user.Version) {
+		return nil, fmt.Errorf("update user %s at version %d: %w", user.ID, user.Version, ErrPreconditionFailed)
+	}
 
 	updates := make(map[string]interface{})
 
@@ -204,12 +339,50 @@ func (s *userServiceImpl) Update(ctx context.Context, req *api.UpdateUserRequest
 		updates["status"] = string(status)
 	}
 	if role, ok := req.Role.Get(); ok {
+		// Support staff acting as a user must not change anyone's access
+		if principal.ImpersonatorID != uuid.Nil {
+			return nil, fmt.Errorf("change role while impersonated by %s: %w", principal.ImpersonatorID, ErrForbidden)
+		}
+		if err := checkRoleGrant(principal, string(role)); err != nil {
+			return nil, err
+		}
 		updates["role"] = string(role)
 	}
 
+	// Demoting or suspending must leave another active superadmin
+	status, statusSet := req.Status.Get()
+	role, roleSet := req.Role.Get()
+	removesSuperadmin := (statusSet && status != api.UserStatusActive) || (roleSet && role != api.UserRoleSuperadmin)
+
 	if len(updates) > 0 {
-		if err := s.db.WithContext(ctx).Model(&user).Updates(updates).Error; err != nil {
-			return nil, fmt.Errorf("update user: %w", err)
+		updates["version"] = gorm.Expr("version + 1")
+		err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if removesSuperadmin {
+				superadmins, err := lockSuperadmins(tx)
+				if err != nil {
+					return err
+				}
+				if err := checkLastSuperadmin(superadmins, []uuid.UUID{user.ID}); err != nil {
+					return fmt.Errorf("update user %s: %w", user.ID, err)
+				}
+			}
+
+			// The version is checked again in case the user changed since it was loaded
+			result := whereIfMatch(tx.Model(&user), params.IfMatch).Updates(updates)
+			if result.Error != nil {
+				return fmt.Errorf("update user: %w", duplicateUserError(result.Error))
+			}
+			if result.RowsAffected == 0 {
+				return fmt.Errorf("update user %s: %w", user.ID, ErrPreconditionFailed)
+			}
+			// Leaving the active status ends every session immediately
+			if status, ok := req.Status.Get(); ok && status != api.UserStatusActive {
+				return revokeUserSessions(ctx, tx, user.ID)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -218,11 +391,11 @@ func (s *userServiceImpl) Update(ctx context.Context, req *api.UpdateUserRequest
 		return nil, fmt.Errorf("reload user: %w", err)
 	}
 
-	result := userToAPI(user)
-	return &result, nil
+	return &api.UserHeaders{ETag: api.NewOptString(versionETag(user.Version)), Response: userToAPI(user)}, nil
 }
 
-// Delete implements UserService
+// Delete implements UserService. Users are soft-deleted so their history is
+// kept; their sessions are revoked.
 func (s *userServiceImpl) Delete(ctx context.Context, params api.DeleteUserParams) (api.DeleteUserRes, error) {
 	select {
 	case <-ctx.Done():
@@ -230,56 +403,260 @@ func (s *userServiceImpl) Delete(ctx context.Context, params api.DeleteUserParam
 	default:
 	}
 
-	result := s.db.WithContext(ctx).Where("id = ?", params.UserId).Delete(&models.User{})
-	if result.Error != nil {
-		return nil, fmt.Errorf("delete user: %w", result.Error)
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthorized
 	}
 
-	if result.RowsAffected == 0 {
+	userID, err := uuid.Parse(params.UserId)
+	if err != nil {
+		return &api.DeleteUserNotFound{}, nil
+	}
+
+	found := true
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		superadmins, err := lockSuperadmins(tx)
+		if err != nil {
+			return err
+		}
+
+		var user models.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "role").
+			Where("id = ?", userID).First(&user).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				found = false
+				return nil
+			}
+			return fmt.Errorf("get user: %w", err)
+		}
+		if err := checkUserChange(principal, user); err != nil {
+			return err
+		}
+		if err := checkLastSuperadmin(superadmins, []uuid.UUID{userID}); err != nil {
+			return fmt.Errorf("delete user %s: %w", userID, err)
+		}
+
+		result := softDeleteUsers(whereIfMatch(tx.Where("id = ?", userID), params.IfMatch))
+		if result.Error != nil {
+			return fmt.Errorf("delete user: %w", result.Error)
+		}
+		// The user is locked, so only If-Match naming another version leaves it
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("delete user %s: %w", userID, ErrPreconditionFailed)
+		}
+		return revokeUserSessions(ctx, tx, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
 		return &api.DeleteUserNotFound{}, nil
 	}
 
 	return &api.DeleteUserNoContent{}, nil
 }
 
-// Invite implements UserService
-func (s *userServiceImpl) Invite(ctx context.Context, req *api.InviteUserRequest) (*api.User, error) {
+// softDeleteUsers marks the users query selects as deleted. Like any other
+// change it bumps their version, so an ETag read before the delete no longer
+// matches.
+func softDeleteUsers(query *gorm.DB) *gorm.DB {
+	return query.Model(&models.User{}).Updates(map[string]any{"deleted_at": time.Now(), "version": gorm.Expr("version + 1")})
+}
+
+// Restore implements UserService
+func (s *userServiceImpl) Restore(ctx context.Context, params api.RestoreUserParams) (api.RestoreUserRes, error) {
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
 	default:
 	}
 
-	// Generate username from email
-	username := strings.Split(req.Email, "@")[0]
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+
+	var user models.User
+	if err := s.db.WithContext(ctx).Unscoped().Where("id = ?", params.UserId).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.RestoreUserNotFound{}, nil
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+	if err := checkUserChange(principal, user); err != nil {
+		return nil, err
+	}
+	if !ifMatchAllows(params.IfMatch, user.Version) {
+		return nil, fmt.Errorf("restore user %s at version %d: %w", user.ID, user.Version, ErrPreconditionFailed)
+	}
+
+	// Restoring a user that is not deleted changes nothing
+	if user.DeletedAt.Valid {
+		updates := map[string]any{"deleted_at": nil, "version": gorm.Expr("version + 1")}
+		// The version is checked again in case the user changed since it was loaded
+		result := whereIfMatch(s.db.WithContext(ctx).Unscoped().Model(&user), params.IfMatch).Updates(updates)
+		if result.Error != nil {
+			return nil, fmt.Errorf("restore user: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil, fmt.Errorf("restore user %s: %w", user.ID, ErrPreconditionFailed)
+		}
+		user.DeletedAt = gorm.DeletedAt{}
+		user.Version++
+	}
+
+	return &api.UserHeaders{ETag: api.NewOptString(versionETag(user.Version)), Response: userToAPI(user)}, nil
+}
 
-	// Generate a temporary password
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("invited123"), bcrypt.DefaultCost)
+// purgedUserModels are the per-user records removed along with a purged user.
+// Login and impersonation events are kept as an audit trail.
+var purgedUserModels = []any{
+	&models.Invitation{},
+	&models.PasswordReset{},
+	&models.PasswordHistory{},
+	&models.UserMFA{},
+	&models.MFARecoveryCode{},
+	&models.MFAChallenge{},
+	&models.APIKey{},
+}
+
+// Purge implements UserService
+func (s *userServiceImpl) Purge(ctx context.Context, params api.PurgeUserParams) (api.PurgeUserRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	userID, err := uuid.Parse(params.UserId)
 	if err != nil {
-		return nil, fmt.Errorf("hash password: %w", err)
+		return &api.PurgeUserNotFound{}, nil
+	}
+
+	var user models.User
+	if err := s.db.WithContext(ctx).Unscoped().Select("id", "deleted_at").Where("id = ?", userID).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.PurgeUserNotFound{}, nil
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+	// Purging is only the second step, so a user cannot be lost by one mistake
+	if !user.DeletedAt.Valid {
+		return nil, fmt.Errorf("purge user %s: %w", userID, ErrUserNotDeleted)
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := revokeUserSessions(ctx, tx, userID); err != nil {
+			return err
+		}
+		for _, model := range purgedUserModels {
+			if err := tx.Where("user_id = ?", userID).Delete(model).Error; err != nil {
+				return fmt.Errorf("purge %T: %w", model, err)
+			}
+		}
+		if err := tx.Unscoped().Delete(&models.User{}, "id = ?", userID).Error; err != nil {
+			return fmt.Errorf("purge user: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.PurgeUserNoContent{}, nil
+}
+
+// Invite implements UserService
+func (s *userServiceImpl) Invite(ctx context.Context, req *api.InviteUserRequest) (*api.User, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+	if err := checkRoleGrant(principal, string(req.Role)); err != nil {
+		return nil, err
 	}
 
+	// Names and password are set by the invitee when accepting; the empty
+	// password hash never matches so the account cannot be used before then
 	user := &models.User{
-		FirstName: "Invited",
-		LastName:  "User",
-		Username:  username,
-		Email:     req.Email,
-		Password:  string(hashedPassword),
-		Role:      string(req.Role),
-		Status:    "invited",
+		Username: req.Username.Or(""),
+		Email:    req.Email,
+		Role:     string(req.Role),
+		Status:   "invited",
 	}
 
-	if err := s.db.WithContext(ctx).Create(user).Error; err != nil {
-		if isDuplicateKeyError(err) {
-			return nil, fmt.Errorf("invite user: %w", ErrDuplicateEmail)
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := insertUser(tx, user); err != nil {
+			return fmt.Errorf("invite user: %w", err)
 		}
-		return nil, fmt.Errorf("invite user: %w", err)
+		return s.invitations.send(ctx, tx, *user)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	result := userToAPI(*user)
 	return &result, nil
 }
 
+// Unlock implements UserService
+func (s *userServiceImpl) Unlock(ctx context.Context, params api.UnlockUserParams) (api.UnlockUserRes, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var user models.User
+	if err := s.db.WithContext(ctx).Select("id", "email", "role").Where("id = ?", params.UserId).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.UnlockUserNotFound{}, nil
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+	if err := authorizeUserChange(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if err := resetAccountThrottle(s.db.WithContext(ctx), user.Email); err != nil {
+		return nil, err
+	}
+
+	return &api.UnlockUserNoContent{}, nil
+}
+
+// userSortValue returns the user's value for a sort column
+func userSortValue(u models.User, column string) any {
+	switch column {
+	case "first_name":
+		return u.FirstName
+	case "last_name":
+		return u.LastName
+	case "username":
+		return u.Username
+	case "email":
+		return u.Email
+	case "phone_number":
+		return u.PhoneNumber
+	case "status":
+		return u.Status
+	case "role":
+		return u.Role
+	case "created_at":
+		return u.CreatedAt
+	case "updated_at":
+		return u.UpdatedAt
+	default:
+		return u.ID
+	}
+}
+
 // userToAPI converts a models.User to api.User
 func userToAPI(u models.User) api.User {
 	result := api.User{
@@ -292,17 +669,15 @@ func userToAPI(u models.User) api.User {
 		Role:      api.UserRole(u.Role),
 		CreatedAt: api.NewOptDateTime(u.CreatedAt),
 		UpdatedAt: api.NewOptDateTime(u.UpdatedAt),
+		Version:   u.Version,
 	}
 
 	if u.PhoneNumber != "" {
 		result.PhoneNumber = api.NewOptString(u.PhoneNumber)
 	}
+	if u.DeletedAt.Valid {
+		result.DeletedAt = api.NewOptDateTime(u.DeletedAt.Time)
+	}
 
 	return result
 }
-
-// isDuplicateKeyError checks if the error is a duplicate key error
-func isDuplicateKeyError(err error) bool {
-	return strings.Contains(err.Error(), "duplicate key") ||
-		strings.Contains(err.Error(), "UNIQUE constraint")
-}
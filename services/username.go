@@ -0,0 +1,116 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+	"gorm.io/gorm"
+)
+
+// Unique indexes on users, as named by gorm for the uniqueIndex tags
+const (
+	usersEmailIndex    = "idx_users_email"
+	usersUsernameIndex = "idx_users_username"
+)
+
+// maxUsernameAttempts bounds the retries when a generated username is taken
+// by a concurrent insert
+const maxUsernameAttempts = 3
+
+// maxUsernameLength is the longest username usernamePattern accepts
+const maxUsernameLength = 64
+
+// usernameSuffixRoom is the space generated usernames keep free for the
+// number that makes them unique
+const usernameSuffixRoom = 4
+
+// usernamePattern matches the usernames callers may choose, as in the API schema
+var usernamePattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// usernameInvalidChars matches what generated usernames leave out of the email
+var usernameInvalidChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// usernameBase derives a username from the part of an email before the @
+func usernameBase(email string) string {
+	local, _, _ := strings.Cut(email, "@")
+	base := usernameInvalidChars.ReplaceAllString(local, "")
+	if runes := []rune(base); len(runes) > maxUsernameLength-usernameSuffixRoom {
+		base = string(runes[:maxUsernameLength-usernameSuffixRoom])
+	}
+	if base == "" {
+		return "user"
+	}
+	return base
+}
+
+// uniqueUsername returns base, or base with the lowest number from 2 appended
+// that no user has. Deleted users keep their usernames until purged.
+func uniqueUsername(db *gorm.DB, base string) (string, error) {
+	// Only base and its numbered forms can collide, not every longer name
+	// sharing the prefix
+	var existing []string
+	if err := db.Unscoped().Model(&models.User{}).
+		Where("username ~ ?", "^"+regexp.QuoteMeta(base)+"[0-9]*$").
+		Pluck("username", &existing).Error; err != nil {
+		return "", fmt.Errorf("find usernames: %w", err)
+	}
+	taken := make(map[string]bool, len(existing))
+	for _, name := range existing {
+		taken[name] = true
+	}
+
+	name := base
+	for n := 2; taken[name]; n++ {
+		name = base + strconv.Itoa(n)
+	}
+	if utf8.RuneCountInString(name) > maxUsernameLength {
+		return "", fmt.Errorf("no free username for %q: %w", base, ErrDuplicateUsername)
+	}
+	return name, nil
+}
+
+// insertUser creates the user. Without a username, one is generated from the
+// email and generated again if a concurrent insert takes it first.
+func insertUser(db *gorm.DB, user *models.User) error {
+	generate := user.Username == ""
+	for attempt := 1; ; attempt++ {
+		if generate {
+			name, err := uniqueUsername(db, usernameBase(user.Email))
+			if err != nil {
+				return err
+			}
+			user.Username = name
+		}
+
+		// The savepoint keeps a failed insert from aborting the caller's transaction
+		err := duplicateUserError(db.Transaction(func(tx *gorm.DB) error {
+			return tx.Create(user).Error
+		}))
+		if generate && errors.Is(err, ErrDuplicateUsername) && attempt < maxUsernameAttempts {
+			continue
+		}
+		return err
+	}
+}
+
+// duplicateUserError reports a unique violation on users as ErrDuplicateEmail
+// or ErrDuplicateUsername, by constraint name. Other errors are returned as is.
+func duplicateUserError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != "23505" {
+		return err
+	}
+	switch pgErr.ConstraintName {
+	case usersEmailIndex:
+		return fmt.Errorf("%s: %w", pgErr.Detail, ErrDuplicateEmail)
+	case usersUsernameIndex:
+		return fmt.Errorf("%s: %w", pgErr.Detail, ErrDuplicateUsername)
+	}
+	return err
+}
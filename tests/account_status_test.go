@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+)
+
+func TestLoginAccountStatus(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions")
+
+	server := createTestServer(t, db)
+
+	testCases := []struct {
+		name       string
+		status     string
+		password   string
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "suspended account",
+			status:     "suspended",
+			password:   "password123",
+			wantStatus: http.StatusForbidden,
+			wantCode:   handlers.Errors.AccountSuspended.Code,
+		},
+		{
+			name:       "inactive account",
+			status:     "inactive",
+			password:   "password123",
+			wantStatus: http.StatusForbidden,
+			wantCode:   handlers.Errors.AccountInactive.Code,
+		},
+		{
+			name:       "invited account",
+			status:     "invited",
+			password:   "password123",
+			wantStatus: http.StatusForbidden,
+			wantCode:   handlers.Errors.InvitationPending.Code,
+		},
+		{
+			name:       "suspended account with wrong password does not reveal status",
+			status:     "suspended",
+			password:   "wrongpassword",
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			email := "status" + string(rune('a'+i)) + "@test.com"
+			user := createTestUser(t, db, email, "password123", "cashier")
+			db.Exec("UPDATE users SET status = ? WHERE id = ?", tc.status, user.ID)
+
+			req := newAPIRequest(t, "POST", "/auth/login", &api.LoginRequest{Email: email, Password: tc.password})
+			rec := httptest.NewRecorder()
+			server.ServeHTTP(rec, req)
+
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("Expected status %d, got %d. Body: %s", tc.wantStatus, rec.Code, rec.Body.String())
+			}
+
+			if tc.wantCode != "" {
+				var response api.ErrorResponse
+				json.Unmarshal(rec.Body.Bytes(), &response)
+				if response.Code != tc.wantCode {
+					t.Errorf("Expected error code %s, got %s", tc.wantCode, response.Code)
+				}
+			}
+		})
+	}
+}
+
+func TestSuspendRevokesTokens(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "refresh_tokens")
+
+	admin := createTestUser(t, db, "admin@test.com", "password123", "superadmin")
+	employee := createTestUser(t, db, "employee@test.com", "password123", "cashier")
+	server := createTestServer(t, db)
+	adminToken := createTestAccessToken(t, db, admin)
+
+	login := loginTestUser(t, server, "employee@test.com", "password123")
+
+	updateReq := &api.UpdateUserRequest{Status: api.NewOptUserStatus(api.UserStatusSuspended)}
+	rec := doWithToken(server, newAPIRequest(t, "PUT", "/users/"+employee.ID.String(), updateReq), adminToken)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	rec = doWithToken(server, httptest.NewRequest("GET", "/auth/me", nil), login.AccessToken)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected access token to be revoked, got %d", rec.Code)
+	}
+
+	var count int64
+	db.Table("sessions").Where("user_id = ?", employee.ID).Count(&count)
+	if count != 0 {
+		t.Errorf("Expected suspended user's sessions to be deleted, %d remain", count)
+	}
+
+	refreshToken, _ := login.RefreshToken.Get()
+	req := newAPIRequest(t, "POST", "/auth/refresh", &api.RefreshTokenRequest{RefreshToken: refreshToken})
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected refresh token to be revoked, got %d", rec.Code)
+	}
+}
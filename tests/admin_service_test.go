@@ -8,10 +8,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+	"github.com/sunfmin/shadcn-admin-go/services"
 )
 
 // ogenEncoder is an interface for ogen-generated types that have MarshalJSON
@@ -49,11 +52,7 @@ func TestAuthLogin(t *testing.T) {
 
 	testUser := createTestUser(t, db, "test@test.com", "password123", "admin")
 
-	handler := createTestHandler(db)
-	server, err := api.NewServer(handler)
-	if err != nil {
-		t.Fatalf("Failed to create server: %v", err)
-	}
+	server := createTestServer(t, db)
 
 	testCases := []struct {
 		name       string
@@ -124,6 +123,17 @@ func TestAuthLogin(t *testing.T) {
 				if diff := cmp.Diff(expected, response, opts...); diff != "" {
 					t.Errorf("LoginResponse mismatch (-want +got):\n%s", diff)
 				}
+
+				claims, err := createTestTokenService().Verify(response.AccessToken)
+				if err != nil {
+					t.Fatalf("Access token does not verify: %v", err)
+				}
+				if claims.UserID != testUser.ID || claims.Role != testUser.Role {
+					t.Errorf("Unexpected token claims: %+v", claims)
+				}
+				if int(claims.ExpiresAt.Unix()) != response.User.Exp {
+					t.Errorf("Token expiry %d does not match user exp %d", claims.ExpiresAt.Unix(), response.User.Exp)
+				}
 			}
 		})
 	}
@@ -133,11 +143,9 @@ func TestAuthLogout(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
 
-	handler := createTestHandler(db)
-	server, err := api.NewServer(handler)
-	if err != nil {
-		t.Fatalf("Failed to create server: %v", err)
-	}
+	defer truncateTables(db, "users")
+
+	server := createAuthorizedTestServer(t, db)
 
 	req := httptest.NewRequest("POST", "/auth/logout", nil)
 	rec := httptest.NewRecorder()
@@ -149,24 +157,182 @@ func TestAuthLogout(t *testing.T) {
 	}
 }
 
-func TestAuthGetCurrentUser(t *testing.T) {
+func TestBearerAuthentication(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
+	defer truncateTables(db, "users")
 
-	handler := createTestHandler(db)
-	server, err := api.NewServer(handler)
+	user := createTestUser(t, db, "user@test.com", "password123", "admin")
+	server := createTestServer(t, db)
+
+	expiredToken, _, err := createTestTokenService().Issue(services.TokenClaims{
+		UserID:    user.ID,
+		Role:      user.Role,
+		ExpiresAt: time.Now().Add(-time.Minute),
+	})
 	if err != nil {
-		t.Fatalf("Failed to create server: %v", err)
+		t.Fatalf("Failed to issue expired token: %v", err)
 	}
 
-	req := httptest.NewRequest("GET", "/auth/me", nil)
-	rec := httptest.NewRecorder()
+	testCases := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+	}{
+		{
+			name:       "missing token",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "malformed token",
+			authorization: "Bearer not-a-token",
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "legacy unsigned token",
+			authorization: "Bearer token_" + user.ID.String() + "_4102444800",
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "expired token",
+			authorization: "Bearer " + expiredToken,
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "valid token",
+			authorization: "Bearer " + createTestAccessToken(t, db, user),
+			wantStatus:    http.StatusOK,
+		},
+	}
 
-	server.ServeHTTP(rec, req)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/users", nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+
+			server.ServeHTTP(rec, req)
+
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("Expected status %d, got %d. Body: %s", tc.wantStatus, rec.Code, rec.Body.String())
+			}
 
-	if rec.Code != http.StatusUnauthorized {
-		t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+			if tc.wantStatus == http.StatusUnauthorized {
+				var response api.ErrorResponse
+				respBody, _ := io.ReadAll(rec.Body)
+				json.Unmarshal(respBody, &response)
+
+				if response.Code != handlers.Errors.Unauthorized.Code {
+					t.Errorf("Expected error code %s, got %s", handlers.Errors.Unauthorized.Code, response.Code)
+				}
+			}
+		})
 	}
+
+	t.Run("login stays anonymous", func(t *testing.T) {
+		req := newAPIRequest(t, "POST", "/auth/login", &api.LoginRequest{
+			Email:    "user@test.com",
+			Password: "password123",
+		})
+		rec := httptest.NewRecorder()
+
+		server.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Errorf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+	})
+}
+
+func TestAuthGetCurrentUser(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	defer truncateTables(db, "users")
+
+	server := createTestServer(t, db)
+
+	t.Run("missing token", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/auth/me", nil)
+		rec := httptest.NewRecorder()
+
+		server.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+	})
+
+	t.Run("authenticated user", func(t *testing.T) {
+		user := createTestUser(t, db, "me@test.com", "password123", "manager")
+		token := createTestAccessToken(t, db, user)
+
+		req := httptest.NewRequest("GET", "/auth/me", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+
+		server.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+
+		var response api.AuthUser
+		respBody, _ := io.ReadAll(rec.Body)
+		if err := json.Unmarshal(respBody, &response); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+
+		claims, err := createTestTokenService().Verify(token)
+		if err != nil {
+			t.Fatalf("Failed to verify token: %v", err)
+		}
+
+		// Build expected from fixtures and the issued token
+		expected := api.AuthUser{
+			AccountNo: user.ID.String(),
+			Email:     user.Email,
+			Role:      []string{user.Role},
+			Exp:       int(claims.ExpiresAt.Unix()),
+		}
+		if diff := cmp.Diff(expected, response); diff != "" {
+			t.Errorf("AuthUser mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("suspended user", func(t *testing.T) {
+		user := createTestUser(t, db, "suspended@test.com", "password123", "cashier")
+		token := createTestAccessToken(t, db, user)
+		db.Exec("UPDATE users SET status = 'suspended' WHERE id = ?", user.ID)
+
+		req := httptest.NewRequest("GET", "/auth/me", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+
+		server.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+	})
+
+	t.Run("deleted user", func(t *testing.T) {
+		user := createTestUser(t, db, "deleted@test.com", "password123", "cashier")
+		token := createTestAccessToken(t, db, user)
+		db.Exec("DELETE FROM users WHERE id = ?", user.ID)
+
+		req := httptest.NewRequest("GET", "/auth/me", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+
+		server.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+	})
 }
 
 func TestUserCRUD(t *testing.T) {
@@ -174,11 +340,7 @@ func TestUserCRUD(t *testing.T) {
 	defer cleanup()
 	defer truncateTables(db, "users")
 
-	handler := createTestHandler(db)
-	server, err := api.NewServer(handler)
-	if err != nil {
-		t.Fatalf("Failed to create server: %v", err)
-	}
+	server := createAuthorizedTestServer(t, db)
 
 	var createdUserID string
 
@@ -212,6 +374,7 @@ func TestUserCRUD(t *testing.T) {
 			Email:     "john.doe@test.com",
 			Status:    api.UserStatusActive,
 			Role:      api.UserRoleAdmin,
+			Version:   1,
 		}
 
 		// Use IgnoreFields for generated fields (ID, timestamps)
@@ -237,8 +400,8 @@ func TestUserCRUD(t *testing.T) {
 		respBody, _ := io.ReadAll(rec.Body)
 		json.Unmarshal(respBody, &response)
 
-		if len(response.Data) != 1 {
-			t.Errorf("Expected 1 user, got %d", len(response.Data))
+		if len(response.Data) != 2 {
+			t.Errorf("Expected 2 users (admin and created), got %d", len(response.Data))
 		}
 	})
 
@@ -281,11 +444,7 @@ func TestUserInvite(t *testing.T) {
 	defer cleanup()
 	defer truncateTables(db, "users")
 
-	handler := createTestHandler(db)
-	server, err := api.NewServer(handler)
-	if err != nil {
-		t.Fatalf("Failed to create server: %v", err)
-	}
+	server := createAuthorizedTestServer(t, db)
 
 	inviteReq := &api.InviteUserRequest{
 		Email: "invited@test.com",
@@ -305,13 +464,12 @@ func TestUserInvite(t *testing.T) {
 	json.Unmarshal(respBody, &response)
 
 	// Build expected from input only - do NOT copy from actual
-	// Service sets default names for invited users
+	// Names stay empty until the invitee accepts
 	expected := api.User{
-		FirstName: "Invited", // Default for invited users
-		LastName:  "User",    // Default for invited users
 		Email:     inviteReq.Email,
 		Status:    api.UserStatusInvited,
 		Role:      inviteReq.Role,
+		Version:   1,
 	}
 
 	// Use IgnoreFields for generated fields (ID, Username, timestamps)
@@ -328,11 +486,7 @@ func TestTaskCRUD(t *testing.T) {
 	defer cleanup()
 	defer truncateTables(db, "tasks")
 
-	handler := createTestHandler(db)
-	server, err := api.NewServer(handler)
-	if err != nil {
-		t.Fatalf("Failed to create server: %v", err)
-	}
+	server := createAuthorizedTestServer(t, db)
 
 	var createdTaskID string
 
@@ -364,6 +518,7 @@ func TestTaskCRUD(t *testing.T) {
 			Status:   createReq.Status,
 			Label:    createReq.Label,
 			Priority: createReq.Priority,
+			Version:  1,
 		}
 
 		// Use IgnoreFields for generated fields (ID, timestamps)
@@ -451,6 +606,7 @@ func TestTaskCRUD(t *testing.T) {
 			// Label and Priority not updated, so we need to check them from original create
 			Label:    api.TaskLabelFeature,
 			Priority: api.TaskPriorityHigh,
+			Version:  2,
 		}
 
 		// Use IgnoreFields for generated fields (timestamps)
@@ -484,11 +640,7 @@ func TestAppOperations(t *testing.T) {
 	createTestApp(t, db, "slack", "Slack", "Team messaging", false)
 	createTestApp(t, db, "github", "GitHub", "Code hosting", true)
 
-	handler := createTestHandler(db)
-	server, err := api.NewServer(handler)
-	if err != nil {
-		t.Fatalf("Failed to create server: %v", err)
-	}
+	server := createAuthorizedTestServer(t, db)
 
 	t.Run("list apps", func(t *testing.T) {
 		req := httptest.NewRequest("GET", "/apps", nil)
@@ -577,11 +729,7 @@ func TestChatOperations(t *testing.T) {
 	chat := createTestChat(t, db, "chat-1", "johndoe", "John Doe")
 	createTestChatMessage(t, db, chat.ID, "johndoe", "Hello!")
 
-	handler := createTestHandler(db)
-	server, err := api.NewServer(handler)
-	if err != nil {
-		t.Fatalf("Failed to create server: %v", err)
-	}
+	server := createAuthorizedTestServer(t, db)
 
 	t.Run("list chats", func(t *testing.T) {
 		req := httptest.NewRequest("GET", "/chats", nil)
@@ -665,11 +813,7 @@ func TestDashboardEndpoints(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
 
-	handler := createTestHandler(db)
-	server, err := api.NewServer(handler)
-	if err != nil {
-		t.Fatalf("Failed to create server: %v", err)
-	}
+	server := createAuthorizedTestServer(t, db)
 
 	t.Run("get dashboard stats", func(t *testing.T) {
 		req := httptest.NewRequest("GET", "/dashboard/stats", nil)
@@ -737,11 +881,7 @@ func TestUserFilters(t *testing.T) {
 	ctx := context.Background()
 	db.WithContext(ctx).Exec("UPDATE users SET status = 'inactive' WHERE email = 'active2@test.com'")
 
-	handler := createTestHandler(db)
-	server, err := api.NewServer(handler)
-	if err != nil {
-		t.Fatalf("Failed to create server: %v", err)
-	}
+	server := createAuthorizedTestServer(t, db)
 
 	t.Run("filter by status", func(t *testing.T) {
 		req := httptest.NewRequest("GET", "/users?status=active", nil)
@@ -756,8 +896,8 @@ func TestUserFilters(t *testing.T) {
 		respBody, _ := io.ReadAll(rec.Body)
 		json.Unmarshal(respBody, &response)
 
-		if len(response.Data) != 1 {
-			t.Errorf("Expected 1 active user, got %d", len(response.Data))
+		if len(response.Data) != 2 {
+			t.Errorf("Expected 2 active users (admin and active1), got %d", len(response.Data))
 		}
 	})
 
@@ -789,11 +929,7 @@ func TestTaskFilters(t *testing.T) {
 	createTestTask(t, db, "TASK-0002", "Feature request", "in progress", "feature", "medium")
 	createTestTask(t, db, "TASK-0003", "Documentation", "done", "documentation", "low")
 
-	handler := createTestHandler(db)
-	server, err := api.NewServer(handler)
-	if err != nil {
-		t.Fatalf("Failed to create server: %v", err)
-	}
+	server := createAuthorizedTestServer(t, db)
 
 	t.Run("filter by status", func(t *testing.T) {
 		req := httptest.NewRequest("GET", "/tasks?status=todo", nil)
@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+)
+
+// createTestAPIKey creates an API key through the API and returns it with its secret
+func createTestAPIKey(t *testing.T, server http.Handler, token string, req *api.CreateApiKeyRequest) api.CreatedApiKey {
+	t.Helper()
+	rec := doWithToken(server, newAPIRequest(t, "POST", "/auth/api-keys", req), token)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusCreated, rec.Code, rec.Body.String())
+	}
+	var created api.CreatedApiKey
+	json.Unmarshal(rec.Body.Bytes(), &created)
+	return created
+}
+
+func TestAPIKeys(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "api_keys")
+
+	admin := createTestUser(t, db, "admin@test.com", "password123", "admin")
+	cashier := createTestUser(t, db, "cashier@test.com", "password123", "cashier")
+	server := createTestServer(t, db)
+	adminToken := createTestAccessToken(t, db, admin)
+
+	created := createTestAPIKey(t, server, adminToken, &api.CreateApiKeyRequest{
+		Name:   "nightly sync",
+		Scopes: []api.ApiKeyScope{api.ApiKeyScopeUsersRead, api.ApiKeyScopeUsersRead},
+	})
+
+	t.Run("secret is shown once and only its hash is stored", func(t *testing.T) {
+		if !strings.HasPrefix(created.Secret, created.ApiKey.Prefix) {
+			t.Errorf("Expected secret to start with prefix %q", created.ApiKey.Prefix)
+		}
+		if len(created.ApiKey.Scopes) != 1 {
+			t.Errorf("Expected duplicate scopes to collapse, got %v", created.ApiKey.Scopes)
+		}
+
+		var stored models.APIKey
+		db.First(&stored, "id = ?", created.ApiKey.ID)
+		if strings.Contains(stored.KeyHash, created.Secret) || stored.KeyHash == "" {
+			t.Error("Expected only a hash of the secret to be stored")
+		}
+
+		rec := doWithToken(server, httptest.NewRequest("GET", "/auth/api-keys", nil), adminToken)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		if strings.Contains(rec.Body.String(), created.Secret) {
+			t.Error("Expected listing not to reveal the secret")
+		}
+		var list api.ApiKeyListResponse
+		json.Unmarshal(rec.Body.Bytes(), &list)
+		if len(list.Data) != 1 || list.Data[0].Name != "nightly sync" {
+			t.Errorf("Unexpected key list %+v", list.Data)
+		}
+	})
+
+	t.Run("key acts as its owner within its scopes", func(t *testing.T) {
+		rec := doWithToken(server, httptest.NewRequest("GET", "/users", nil), created.Secret)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+
+		var stored models.APIKey
+		db.First(&stored, "id = ?", created.ApiKey.ID)
+		if stored.LastUsedAt == nil {
+			t.Error("Expected last used time to be recorded")
+		}
+
+		testCases := []struct {
+			name   string
+			method string
+			path   string
+		}{
+			{name: "out of scope", method: "GET", path: "/tasks"},
+			{name: "writes need the write scope", method: "DELETE", path: "/users/" + cashier.ID.String()},
+			{name: "cannot mint more keys", method: "GET", path: "/auth/api-keys"},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				rec := doWithToken(server, httptest.NewRequest(tc.method, tc.path, nil), created.Secret)
+				if rec.Code != http.StatusForbidden {
+					t.Errorf("Expected status %d, got %d", http.StatusForbidden, rec.Code)
+				}
+			})
+		}
+	})
+
+	t.Run("scopes do not exceed the owner's role", func(t *testing.T) {
+		key := createTestAPIKey(t, server, createTestAccessToken(t, db, cashier), &api.CreateApiKeyRequest{
+			Name:   "cashier script",
+			Scopes: []api.ApiKeyScope{api.ApiKeyScopeUsersRead},
+		})
+		rec := doWithToken(server, httptest.NewRequest("GET", "/users", nil), key.Secret)
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("Expected status %d, got %d", http.StatusForbidden, rec.Code)
+		}
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		rec := doWithToken(server, newAPIRequest(t, "POST", "/auth/api-keys", &api.CreateApiKeyRequest{
+			Name:      "already expired",
+			Scopes:    []api.ApiKeyScope{api.ApiKeyScopeTasksRead},
+			ExpiresAt: api.NewOptDateTime(time.Now().Add(-time.Hour)),
+		}), adminToken)
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("Expected status %d, got %d", http.StatusBadRequest, rec.Code)
+		}
+		var response api.ErrorResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		if response.Code != handlers.Errors.InvalidAPIKeyExpiry.Code {
+			t.Errorf("Expected error code %s, got %s", handlers.Errors.InvalidAPIKeyExpiry.Code, response.Code)
+		}
+
+		key := createTestAPIKey(t, server, adminToken, &api.CreateApiKeyRequest{
+			Name:      "short lived",
+			Scopes:    []api.ApiKeyScope{api.ApiKeyScopeTasksRead},
+			ExpiresAt: api.NewOptDateTime(time.Now().Add(time.Hour)),
+		})
+		db.Model(&models.APIKey{}).Where("id = ?", key.ApiKey.ID).Update("expires_at", time.Now().Add(-time.Minute))
+		if rec := doWithToken(server, httptest.NewRequest("GET", "/tasks", nil), key.Secret); rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected expired key to get %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+	})
+
+	t.Run("suspending the owner disables the key", func(t *testing.T) {
+		key := createTestAPIKey(t, server, createTestAccessToken(t, db, cashier), &api.CreateApiKeyRequest{
+			Name:   "tasks",
+			Scopes: []api.ApiKeyScope{api.ApiKeyScopeTasksRead},
+		})
+		db.Model(cashier).Update("status", "suspended")
+		defer db.Model(cashier).Update("status", "active")
+
+		if rec := doWithToken(server, httptest.NewRequest("GET", "/tasks", nil), key.Secret); rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+	})
+
+	t.Run("revoked keys stop working", func(t *testing.T) {
+		path := "/auth/api-keys/" + created.ApiKey.ID.String()
+
+		// Keys are private to their owner
+		rec := doWithToken(server, httptest.NewRequest("DELETE", path, nil), createTestAccessToken(t, db, cashier))
+		if rec.Code != http.StatusNotFound {
+			t.Fatalf("Expected other user to get %d, got %d", http.StatusNotFound, rec.Code)
+		}
+
+		rec = doWithToken(server, httptest.NewRequest("DELETE", path, nil), adminToken)
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusNoContent, rec.Code, rec.Body.String())
+		}
+		if rec := doWithToken(server, httptest.NewRequest("GET", "/users", nil), created.Secret); rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected revoked key to get %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+	})
+}
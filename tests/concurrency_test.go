@@ -0,0 +1,150 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+)
+
+// withIfMatch sets the If-Match header on req
+func withIfMatch(req *http.Request, etag string) *http.Request {
+	req.Header.Set("If-Match", etag)
+	return req
+}
+
+func TestUserOptimisticConcurrency(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions")
+
+	server := createAuthorizedTestServer(t, db)
+	alice := createTestUser(t, db, "alice@test.com", "password123", "cashier")
+	path := "/users/" + alice.ID.String()
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest("GET", path, nil))
+	if got := rec.Header().Get("ETag"); got != `"1"` {
+		t.Fatalf("Expected ETag %q, got %q", `"1"`, got)
+	}
+
+	update := func(t *testing.T, firstName, etag string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := newAPIRequest(t, "PUT", path, &api.UpdateUserRequest{FirstName: api.NewOptString(firstName)})
+		if etag != "" {
+			withIfMatch(req, etag)
+		}
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Both admins read version 1; the first write wins
+	rec = update(t, "First", `"1"`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	var user api.User
+	json.Unmarshal(rec.Body.Bytes(), &user)
+	if user.Version != 2 || rec.Header().Get("ETag") != `"2"` {
+		t.Errorf("Expected version 2, got %d and ETag %q", user.Version, rec.Header().Get("ETag"))
+	}
+
+	rec = update(t, "Second", `"1"`)
+	assertErrorCode(t, rec, http.StatusPreconditionFailed, handlers.Errors.PreconditionFailed.Code)
+
+	t.Run("any listed version or a wildcard matches", func(t *testing.T) {
+		if rec := update(t, "Third", `"7", "2"`); rec.Code != http.StatusOK {
+			t.Errorf("Expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		if rec := update(t, "Fourth", "*"); rec.Code != http.StatusOK {
+			t.Errorf("Expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		// Without If-Match the update is unconditional, as before
+		if rec := update(t, "Fifth", ""); rec.Code != http.StatusOK {
+			t.Errorf("Expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, withIfMatch(httptest.NewRequest("DELETE", path, nil), `"1"`))
+		assertErrorCode(t, rec, http.StatusPreconditionFailed, handlers.Errors.PreconditionFailed.Code)
+
+		rec = httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest("GET", path, nil))
+		etag := rec.Header().Get("ETag")
+
+		rec = httptest.NewRecorder()
+		server.ServeHTTP(rec, withIfMatch(httptest.NewRequest("DELETE", path, nil), etag))
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusNoContent, rec.Code, rec.Body.String())
+		}
+
+		// The delete is a change of its own, so the ETag read before it is stale
+		rec = httptest.NewRecorder()
+		server.ServeHTTP(rec, withIfMatch(httptest.NewRequest("POST", path+"/restore", nil), etag))
+		assertErrorCode(t, rec, http.StatusPreconditionFailed, handlers.Errors.PreconditionFailed.Code)
+
+		rec = httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest("GET", path+"?includeDeleted=true", nil))
+		deletedETag := rec.Header().Get("ETag")
+		if deletedETag == etag {
+			t.Fatalf("Expected the delete to change the ETag %s", etag)
+		}
+
+		rec = httptest.NewRecorder()
+		server.ServeHTTP(rec, withIfMatch(httptest.NewRequest("POST", path+"/restore", nil), deletedETag))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		if got := rec.Header().Get("ETag"); got == deletedETag {
+			t.Errorf("Expected the restore to change the ETag %s", deletedETag)
+		}
+	})
+}
+
+func TestTaskOptimisticConcurrency(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "tasks")
+
+	server := createAuthorizedTestServer(t, db)
+	createTestTask(t, db, "TASK-0001", "Original", "todo", "feature", "medium")
+	path := "/tasks/TASK-0001"
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest("GET", path, nil))
+	etag := rec.Header().Get("ETag")
+	if etag != `"1"` {
+		t.Fatalf("Expected ETag %q, got %q", `"1"`, etag)
+	}
+
+	for _, title := range []string{"Mine", "Theirs"} {
+		req := withIfMatch(newAPIRequest(t, "PUT", path, &api.UpdateTaskRequest{Title: api.NewOptString(title)}), etag)
+		rec = httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+	}
+	assertErrorCode(t, rec, http.StatusPreconditionFailed, handlers.Errors.PreconditionFailed.Code)
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest("GET", path, nil))
+	var task api.Task
+	json.Unmarshal(rec.Body.Bytes(), &task)
+	if task.Title != "Mine" || task.Version != 2 {
+		t.Errorf("Expected the first update to be kept, got %q at version %d", task.Title, task.Version)
+	}
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, withIfMatch(httptest.NewRequest("DELETE", path, nil), etag))
+	assertErrorCode(t, rec, http.StatusPreconditionFailed, handlers.Errors.PreconditionFailed.Code)
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, withIfMatch(httptest.NewRequest("DELETE", path, nil), `"2"`))
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("Expected status %d, got %d. Body: %s", http.StatusNoContent, rec.Code, rec.Body.String())
+	}
+}
@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+)
+
+func TestImpersonation(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "refresh_tokens", "impersonation_events")
+
+	superadmin := createTestUser(t, db, "root@test.com", "password123", "superadmin")
+	admin := createTestUser(t, db, "admin@test.com", "password123", "admin")
+	cashier := createTestUser(t, db, "cashier@test.com", "password123", "cashier")
+	server := createTestServer(t, db)
+	rootToken := createTestAccessToken(t, db, superadmin)
+
+	// impersonate starts an impersonation of the user as the superadmin
+	impersonate := func(t *testing.T, userID string) api.LoginResponse {
+		t.Helper()
+		req := newAPIRequest(t, "POST", "/users/"+userID+"/impersonate", &api.ImpersonateRequest{Reason: "ticket 42"})
+		rec := doWithToken(server, req, rootToken)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		var response api.LoginResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		if response.RefreshToken.IsSet() {
+			t.Error("Expected no refresh token for an impersonation")
+		}
+		return response
+	}
+
+	t.Run("only superadmins may impersonate", func(t *testing.T) {
+		req := newAPIRequest(t, "POST", "/users/"+cashier.ID.String()+"/impersonate", &api.ImpersonateRequest{Reason: "curious"})
+		if rec := doWithToken(server, req, createTestAccessToken(t, db, admin)); rec.Code != http.StatusForbidden {
+			t.Errorf("Expected status %d, got %d", http.StatusForbidden, rec.Code)
+		}
+	})
+
+	t.Run("superadmins cannot be impersonated", func(t *testing.T) {
+		other := createTestUser(t, db, "root2@test.com", "password123", "superadmin")
+		req := newAPIRequest(t, "POST", "/users/"+other.ID.String()+"/impersonate", &api.ImpersonateRequest{Reason: "ticket 42"})
+		rec := doWithToken(server, req, rootToken)
+		if rec.Code != http.StatusForbidden {
+			t.Fatalf("Expected status %d, got %d", http.StatusForbidden, rec.Code)
+		}
+		var response api.ErrorResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		if response.Code != handlers.Errors.CannotImpersonate.Code {
+			t.Errorf("Expected error code %s, got %s", handlers.Errors.CannotImpersonate.Code, response.Code)
+		}
+	})
+
+	t.Run("token acts as the user and reveals the impersonator", func(t *testing.T) {
+		login := impersonate(t, cashier.ID.String())
+
+		rec := doWithToken(server, httptest.NewRequest("GET", "/auth/me", nil), login.AccessToken)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		var me api.AuthUser
+		json.Unmarshal(rec.Body.Bytes(), &me)
+		if me.AccountNo != cashier.ID.String() {
+			t.Errorf("Expected account %s, got %s", cashier.ID, me.AccountNo)
+		}
+		if impersonator, ok := me.Impersonator.Get(); !ok || impersonator.AccountNo != superadmin.ID.String() {
+			t.Errorf("Expected impersonator %s, got %+v", superadmin.ID, me.Impersonator)
+		}
+
+		// Cashier permissions apply, not superadmin ones
+		if rec := doWithToken(server, httptest.NewRequest("GET", "/users", nil), login.AccessToken); rec.Code != http.StatusForbidden {
+			t.Errorf("Expected status %d, got %d", http.StatusForbidden, rec.Code)
+		}
+
+		req := newAPIRequest(t, "POST", "/auth/password/change", &api.ChangePasswordRequest{CurrentPassword: "password123", NewPassword: "hijacked123"})
+		if rec := doWithToken(server, req, login.AccessToken); rec.Code != http.StatusForbidden {
+			t.Errorf("Expected password change to get %d, got %d", http.StatusForbidden, rec.Code)
+		}
+
+		// Stopping ends the token
+		if rec := doWithToken(server, httptest.NewRequest("POST", "/auth/impersonation/stop", nil), login.AccessToken); rec.Code != http.StatusNoContent {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusNoContent, rec.Code, rec.Body.String())
+		}
+		if rec := doWithToken(server, httptest.NewRequest("GET", "/auth/me", nil), login.AccessToken); rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected stopped token to get %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+
+		var events []models.ImpersonationEvent
+		db.Where("user_id = ?", cashier.ID).Order("created_at").Find(&events)
+		if len(events) != 2 || events[0].Action != "start" || events[1].Action != "stop" {
+			t.Fatalf("Expected start and stop events, got %+v", events)
+		}
+		if events[0].ImpersonatorID != superadmin.ID || events[0].Reason != "ticket 42" {
+			t.Errorf("Unexpected start event %+v", events[0])
+		}
+	})
+
+	t.Run("impersonated admin cannot change roles", func(t *testing.T) {
+		login := impersonate(t, admin.ID.String())
+		role := api.UserRoleAdmin
+		req := newAPIRequest(t, "PUT", "/users/"+cashier.ID.String(), &api.UpdateUserRequest{Role: api.NewOptUserRole(role)})
+		if rec := doWithToken(server, req, login.AccessToken); rec.Code != http.StatusForbidden {
+			t.Errorf("Expected status %d, got %d", http.StatusForbidden, rec.Code)
+		}
+
+		req = newAPIRequest(t, "PUT", "/users/"+cashier.ID.String(), &api.UpdateUserRequest{FirstName: api.NewOptString("Renamed")})
+		if rec := doWithToken(server, req, login.AccessToken); rec.Code != http.StatusOK {
+			t.Errorf("Expected other updates to get %d, got %d", http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("impersonated admin cannot add users", func(t *testing.T) {
+		login := impersonate(t, admin.ID.String())
+		for name, req := range map[string]*http.Request{
+			"create": newAPIRequest(t, "POST", "/users", &api.CreateUserRequest{FirstName: "New", LastName: "Admin", Email: "new@test.com", Role: api.UserRoleAdmin}),
+			"invite": newAPIRequest(t, "POST", "/users/invite", &api.InviteUserRequest{Email: "new@test.com", Role: api.UserRoleAdmin}),
+			"import": newImportRequest(t, "email,firstName,lastName,role\nnew@test.com,New,Admin,admin\n", false, false),
+		} {
+			if rec := doWithToken(server, req, login.AccessToken); rec.Code != http.StatusForbidden {
+				t.Errorf("Expected %s to get %d, got %d", name, http.StatusForbidden, rec.Code)
+			}
+		}
+	})
+
+	t.Run("stop without impersonating", func(t *testing.T) {
+		rec := doWithToken(server, httptest.NewRequest("POST", "/auth/impersonation/stop", nil), rootToken)
+		if rec.Code != http.StatusConflict {
+			t.Errorf("Expected status %d, got %d", http.StatusConflict, rec.Code)
+		}
+	})
+
+	t.Run("demoting the impersonator ends the impersonation", func(t *testing.T) {
+		login := impersonate(t, cashier.ID.String())
+		db.Model(superadmin).Update("role", "admin")
+		defer db.Model(superadmin).Update("role", "superadmin")
+
+		if rec := doWithToken(server, httptest.NewRequest("GET", "/auth/me", nil), login.AccessToken); rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,168 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+	"github.com/sunfmin/shadcn-admin-go/services"
+)
+
+func TestInvitationFlow(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "invitations")
+
+	admin := createTestUser(t, db, "admin@test.com", "password123", "superadmin")
+	server, mailer := createTestServerWithMailer(t, db)
+	adminToken := createTestAccessToken(t, db, admin)
+
+	// invite creates an invitation through the API and returns the invited user
+	invite := func(t *testing.T, email string) api.User {
+		t.Helper()
+		req := newAPIRequest(t, "POST", "/users/invite", &api.InviteUserRequest{Email: email, Role: api.UserRoleCashier})
+		rec := doWithToken(server, req, adminToken)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("Invite failed with status %d. Body: %s", rec.Code, rec.Body.String())
+		}
+		var user api.User
+		json.Unmarshal(rec.Body.Bytes(), &user)
+		return user
+	}
+
+	t.Run("invitee accepts and can log in", func(t *testing.T) {
+		invite(t, "newbie@test.com")
+		token := mailer.tokenFromMail(t, "newbie@test.com")
+
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest("GET", "/auth/invitations/"+token, nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		var details api.InvitationDetails
+		json.Unmarshal(rec.Body.Bytes(), &details)
+		expectedDetails := api.InvitationDetails{Email: "newbie@test.com", Role: api.UserRoleCashier}
+		if diff := cmp.Diff(expectedDetails, details, cmpopts.IgnoreFields(api.InvitationDetails{}, "ExpiresAt")); diff != "" {
+			t.Errorf("InvitationDetails mismatch (-want +got):\n%s", diff)
+		}
+
+		acceptReq := &api.AcceptInvitationRequest{FirstName: "New", LastName: "Bie", Password: "s3cretpass"}
+		rec = httptest.NewRecorder()
+		server.ServeHTTP(rec, newAPIRequest(t, "POST", "/auth/invitations/"+token+"/accept", acceptReq))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		var user api.User
+		json.Unmarshal(rec.Body.Bytes(), &user)
+		expectedUser := api.User{
+			FirstName: "New",
+			LastName:  "Bie",
+			Email:     "newbie@test.com",
+			Status:    api.UserStatusActive,
+			Role:      api.UserRoleCashier,
+			// Invited, then updated on acceptance
+			Version: 2,
+		}
+		opts := cmp.Options{cmpopts.IgnoreFields(api.User{}, "ID", "Username", "CreatedAt", "UpdatedAt")}
+		if diff := cmp.Diff(expectedUser, user, opts...); diff != "" {
+			t.Errorf("User mismatch (-want +got):\n%s", diff)
+		}
+
+		loginTestUser(t, server, "newbie@test.com", "s3cretpass")
+
+		// The token is single-use
+		rec = httptest.NewRecorder()
+		server.ServeHTTP(rec, newAPIRequest(t, "POST", "/auth/invitations/"+token+"/accept", acceptReq))
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("Expected reused token to get %d, got %d", http.StatusNotFound, rec.Code)
+		}
+	})
+
+	t.Run("resend replaces the previous link", func(t *testing.T) {
+		user := invite(t, "resend@test.com")
+		oldToken := mailer.tokenFromMail(t, "resend@test.com")
+
+		rec := doWithToken(server, httptest.NewRequest("POST", "/users/"+user.ID.String()+"/invitation/resend", nil), adminToken)
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusNoContent, rec.Code, rec.Body.String())
+		}
+		newToken := mailer.tokenFromMail(t, "resend@test.com")
+
+		rec = httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest("GET", "/auth/invitations/"+oldToken, nil))
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("Expected old token to get %d, got %d", http.StatusNotFound, rec.Code)
+		}
+
+		rec = httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest("GET", "/auth/invitations/"+newToken, nil))
+		if rec.Code != http.StatusOK {
+			t.Errorf("Expected new token to get %d, got %d", http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("revoke removes the invited user", func(t *testing.T) {
+		user := invite(t, "revoked@test.com")
+		token := mailer.tokenFromMail(t, "revoked@test.com")
+
+		rec := doWithToken(server, httptest.NewRequest("DELETE", "/users/"+user.ID.String()+"/invitation", nil), adminToken)
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusNoContent, rec.Code, rec.Body.String())
+		}
+
+		rec = httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest("GET", "/auth/invitations/"+token, nil))
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("Expected revoked token to get %d, got %d", http.StatusNotFound, rec.Code)
+		}
+
+		rec = doWithToken(server, httptest.NewRequest("GET", "/users/"+user.ID.String(), nil), adminToken)
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("Expected invited user to be removed, got %d", rec.Code)
+		}
+	})
+
+	t.Run("active users have no invitation to resend", func(t *testing.T) {
+		rec := doWithToken(server, httptest.NewRequest("POST", "/users/"+admin.ID.String()+"/invitation/resend", nil), adminToken)
+		if rec.Code != http.StatusConflict {
+			t.Fatalf("Expected status %d, got %d", http.StatusConflict, rec.Code)
+		}
+		var response api.ErrorResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		if response.Code != handlers.Errors.UserNotInvited.Code {
+			t.Errorf("Expected error code %s, got %s", handlers.Errors.UserNotInvited.Code, response.Code)
+		}
+	})
+}
+
+func TestInvitationWithoutMailer(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "invitations")
+
+	admin := createTestUser(t, db, "admin@test.com", "password123", "superadmin")
+	handler := services.NewOgenHandler().
+		WithAuthService(services.NewAuthService(db).WithTokenService(createTestTokenService()).Build()).
+		WithUserService(services.NewUserService(db).Build()).
+		Build()
+	server, err := handlers.NewServer(handler)
+	if err != nil {
+		t.Fatalf("Failed to create server: %v", err)
+	}
+
+	req := newAPIRequest(t, "POST", "/users/invite", &api.InviteUserRequest{Email: "invited@test.com", Role: api.UserRoleCashier})
+	if rec := doWithToken(server, req, createTestAccessToken(t, db, admin)); rec.Code != http.StatusInternalServerError {
+		t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusInternalServerError, rec.Code, rec.Body.String())
+	}
+
+	var count int64
+	db.Table("users").Where("email = ?", "invited@test.com").Count(&count)
+	if count != 0 {
+		t.Error("Expected the invitation to be rolled back")
+	}
+}
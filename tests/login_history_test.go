@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+)
+
+func TestLoginHistory(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "refresh_tokens", "login_throttles", "login_events")
+
+	admin := createTestUser(t, db, "admin@test.com", "password123", "admin")
+	cashier := createTestUser(t, db, "cashier@test.com", "password123", "cashier")
+	server := createTestServer(t, db)
+	adminToken := createTestAccessToken(t, db, admin)
+
+	// login posts a login from a known address and browser
+	login := func(email, password string) *httptest.ResponseRecorder {
+		req := newAPIRequest(t, "POST", "/auth/login", &api.LoginRequest{Email: email, Password: password})
+		req.RemoteAddr = "203.0.113.7:4321"
+		req.Header.Set("User-Agent", "history-test/1.0")
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// history fetches the cashier's login history as the admin
+	history := func(t *testing.T, query string) api.LoginHistoryResponse {
+		t.Helper()
+		rec := doWithToken(server, httptest.NewRequest("GET", "/users/"+cashier.ID.String()+"/login-history"+query, nil), adminToken)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		var response api.LoginHistoryResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		return response
+	}
+
+	if rec := login("cashier@test.com", "wrongpass1"); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+	}
+	if rec := login("cashier@test.com", "password123"); rec.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	db.Model(cashier).Update("status", "suspended")
+	if rec := login("cashier@test.com", "password123"); rec.Code != http.StatusForbidden {
+		t.Fatalf("Expected status %d, got %d", http.StatusForbidden, rec.Code)
+	}
+	db.Model(cashier).Update("status", "active")
+
+	t.Run("attempts are listed newest first", func(t *testing.T) {
+		response := history(t, "")
+		if response.Meta.Total != 3 || len(response.Data) != 3 {
+			t.Fatalf("Expected 3 attempts, got %+v", response)
+		}
+
+		suspended, success, failed := response.Data[0], response.Data[1], response.Data[2]
+		if suspended.Outcome != api.LoginOutcomeFailure || suspended.FailureReason.Or("") != "account_suspended" {
+			t.Errorf("Unexpected suspended attempt %+v", suspended)
+		}
+		if success.Outcome != api.LoginOutcomeSuccess || success.FailureReason.IsSet() {
+			t.Errorf("Unexpected successful attempt %+v", success)
+		}
+		if failed.Outcome != api.LoginOutcomeFailure || failed.FailureReason.Or("") != "invalid_credentials" {
+			t.Errorf("Unexpected failed attempt %+v", failed)
+		}
+		if success.Method != api.LoginMethodPassword ||
+			success.IpAddress.Or("") != "203.0.113.7" ||
+			success.UserAgent.Or("") != "history-test/1.0" {
+			t.Errorf("Expected method, IP and user agent to be recorded, got %+v", success)
+		}
+	})
+
+	t.Run("pagination and outcome filter", func(t *testing.T) {
+		response := history(t, "?page=2&pageSize=2")
+		if response.Meta.TotalPages != 2 || len(response.Data) != 1 {
+			t.Errorf("Expected the last of 2 pages with 1 attempt, got %+v", response)
+		}
+
+		response = history(t, "?outcome=failure")
+		if response.Meta.Total != 2 {
+			t.Errorf("Expected 2 failed attempts, got %d", response.Meta.Total)
+		}
+	})
+
+	t.Run("events in the same instant page in a stable order", func(t *testing.T) {
+		db.Model(&models.LoginEvent{}).Where("user_id = ?", cashier.ID).Update("created_at", time.Now())
+
+		seen := map[uuid.UUID]bool{}
+		for page := 1; page <= 3; page++ {
+			for _, event := range history(t, fmt.Sprintf("?page=%d&pageSize=1", page)).Data {
+				seen[event.ID] = true
+			}
+		}
+		if len(seen) != 3 {
+			t.Errorf("Expected each of 3 attempts once across pages, got %d distinct", len(seen))
+		}
+	})
+
+	t.Run("unknown emails are recorded without an account", func(t *testing.T) {
+		login("nobody@test.com", "password123")
+
+		var event models.LoginEvent
+		if err := db.Where("email = ?", "nobody@test.com").First(&event).Error; err != nil {
+			t.Fatalf("Expected attempt to be recorded: %v", err)
+		}
+		if event.UserID != nil || event.FailureReason != "invalid_credentials" {
+			t.Errorf("Unexpected event %+v", event)
+		}
+	})
+
+	t.Run("access", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			path     string
+			token    string
+			expected int
+		}{
+			{name: "cashiers cannot view history", path: "/users/" + admin.ID.String() + "/login-history", token: createTestAccessToken(t, db, cashier), expected: http.StatusForbidden},
+			{name: "unknown user", path: "/users/00000000-0000-0000-0000-000000000000/login-history", token: adminToken, expected: http.StatusNotFound},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				rec := doWithToken(server, httptest.NewRequest("GET", tc.path, nil), tc.token)
+				if rec.Code != tc.expected {
+					t.Errorf("Expected status %d, got %d", tc.expected, rec.Code)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,198 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+	"github.com/sunfmin/shadcn-admin-go/services"
+	"gorm.io/gorm"
+)
+
+// createThrottledTestServer creates a test server whose logins follow the given throttle policy
+func createThrottledTestServer(t *testing.T, db *gorm.DB, policy services.LoginThrottlePolicy) *api.Server {
+	t.Helper()
+
+	authService := services.NewAuthService(db).
+		WithTokenService(createTestTokenService()).
+		WithLoginThrottlePolicy(policy).
+		Build()
+	handler := services.NewOgenHandler().
+		WithAuthService(authService).
+		WithUserService(services.NewUserService(db).Build()).
+		Build()
+
+	server, err := handlers.NewServer(handler)
+	if err != nil {
+		t.Fatalf("Failed to create server: %v", err)
+	}
+	return server
+}
+
+// attemptLogin posts a login from the given remote address and returns the recorder
+func attemptLogin(t *testing.T, server http.Handler, remoteAddr, email, password string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := newAPIRequest(t, "POST", "/auth/login", &api.LoginRequest{Email: email, Password: password})
+	req.RemoteAddr = remoteAddr
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+	return rec
+}
+
+// assertTooManyAttempts checks for a TOO_MANY_ATTEMPTS response whose Retry-After is within want
+func assertTooManyAttempts(t *testing.T, rec *httptest.ResponseRecorder, want time.Duration) {
+	t.Helper()
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusTooManyRequests, rec.Code, rec.Body.String())
+	}
+	var response api.ErrorResponse
+	json.Unmarshal(rec.Body.Bytes(), &response)
+	if response.Code != handlers.Errors.TooManyAttempts.Code {
+		t.Errorf("Expected error code %s, got %s", handlers.Errors.TooManyAttempts.Code, response.Code)
+	}
+	seconds, err := strconv.Atoi(rec.Header().Get("Retry-After"))
+	if err != nil {
+		t.Fatalf("Expected numeric Retry-After header, got %q", rec.Header().Get("Retry-After"))
+	}
+	if got := time.Duration(seconds) * time.Second; got <= 0 || got > want {
+		t.Errorf("Expected Retry-After in (0, %s], got %s", want, got)
+	}
+}
+
+func TestLoginAccountLockout(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "refresh_tokens", "login_throttles", "login_events")
+
+	user := createTestUser(t, db, "target@test.com", "password123", "cashier")
+	admin := createTestUser(t, db, "admin@test.com", "password123", "superadmin")
+	server := createThrottledTestServer(t, db, services.LoginThrottlePolicy{
+		FreeAttempts:            10,
+		AccountLockoutThreshold: 3,
+		LockoutDuration:         15 * time.Minute,
+		FailureWindow:           time.Hour,
+	})
+
+	t.Run("account locks after the threshold", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			// Spread attempts over addresses so only the account counter trips
+			addr := "198.51.100." + strconv.Itoa(i+1) + ":1234"
+			if rec := attemptLogin(t, server, addr, "target@test.com", "wrongpass1"); rec.Code != http.StatusUnauthorized {
+				t.Fatalf("Attempt %d: expected status %d, got %d", i+1, http.StatusUnauthorized, rec.Code)
+			}
+		}
+
+		// Even the correct password is refused while locked
+		rec := attemptLogin(t, server, "198.51.100.9:1234", "target@test.com", "password123")
+		assertTooManyAttempts(t, rec, 15*time.Minute)
+	})
+
+	t.Run("refusals while locked are recorded once", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			attemptLogin(t, server, "198.51.100.9:1234", "target@test.com", "password123")
+		}
+
+		var count int64
+		db.Model(&models.LoginEvent{}).Where("email = ? AND failure_reason = ?", "target@test.com", "too_many_attempts").Count(&count)
+		if count != 1 {
+			t.Errorf("Expected 1 throttled attempt in the login history, got %d", count)
+		}
+	})
+
+	t.Run("other accounts are unaffected", func(t *testing.T) {
+		rec := attemptLogin(t, server, "198.51.100.1:1234", "admin@test.com", "password123")
+		if rec.Code != http.StatusOK {
+			t.Errorf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+	})
+
+	t.Run("admin unlock lifts the lockout", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/users/"+user.ID.String()+"/unlock", nil)
+		rec := doWithToken(server, req, createTestAccessToken(t, db, admin))
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusNoContent, rec.Code, rec.Body.String())
+		}
+
+		rec = attemptLogin(t, server, "198.51.100.9:1234", "target@test.com", "password123")
+		if rec.Code != http.StatusOK {
+			t.Errorf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+	})
+}
+
+func TestLoginThrottlePerIP(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "refresh_tokens", "login_throttles")
+
+	createTestUser(t, db, "victim@test.com", "password123", "cashier")
+	server := createThrottledTestServer(t, db, services.LoginThrottlePolicy{
+		FreeAttempts:       1,
+		BaseDelay:          time.Minute,
+		MaxDelay:           time.Hour,
+		IPLockoutThreshold: 3,
+		LockoutDuration:    15 * time.Minute,
+		FailureWindow:      time.Hour,
+	})
+
+	t.Run("failures back off exponentially", func(t *testing.T) {
+		if rec := attemptLogin(t, server, "203.0.113.5:1234", "guess1@test.com", "wrongpass1"); rec.Code != http.StatusUnauthorized {
+			t.Fatalf("Expected free attempt to get %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+		if rec := attemptLogin(t, server, "203.0.113.5:1234", "guess2@test.com", "wrongpass1"); rec.Code != http.StatusUnauthorized {
+			t.Fatalf("Expected second attempt to get %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+
+		rec := attemptLogin(t, server, "203.0.113.5:1234", "victim@test.com", "password123")
+		assertTooManyAttempts(t, rec, time.Minute)
+	})
+
+	t.Run("other addresses are unaffected", func(t *testing.T) {
+		rec := attemptLogin(t, server, "203.0.113.6:1234", "victim@test.com", "password123")
+		if rec.Code != http.StatusOK {
+			t.Errorf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+	})
+}
+
+func TestLoginLockoutAcrossMFAChallenges(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "refresh_tokens", "login_throttles", "login_events", "user_mfas", "mfa_challenges")
+
+	user := createTestUser(t, db, "target@test.com", "password123", "cashier")
+	confirmed := time.Now()
+	db.Create(&models.UserMFA{UserID: user.ID, Secret: "JBSWY3DPEHPK3PXP", ConfirmedAt: &confirmed})
+	server := createThrottledTestServer(t, db, services.LoginThrottlePolicy{
+		FreeAttempts:            10,
+		AccountLockoutThreshold: 3,
+		LockoutDuration:         15 * time.Minute,
+		FailureWindow:           time.Hour,
+	})
+
+	// Each round knows the password but guesses the second factor wrong
+	for i := 0; i < 3; i++ {
+		rec := attemptLogin(t, server, "198.51.100.1:1234", "target@test.com", "password123")
+		if rec.Code != http.StatusAccepted {
+			t.Fatalf("Round %d: expected status %d, got %d. Body: %s", i+1, http.StatusAccepted, rec.Code, rec.Body.String())
+		}
+		var challenge api.MfaChallenge
+		json.Unmarshal(rec.Body.Bytes(), &challenge)
+
+		req := newAPIRequest(t, "POST", "/auth/mfa/verify", &api.VerifyMfaRequest{MfaToken: challenge.MfaToken, RecoveryCode: api.NewOptString("wrong-guess")})
+		rec = httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		if rec.Code != http.StatusUnauthorized {
+			t.Fatalf("Round %d: expected status %d, got %d", i+1, http.StatusUnauthorized, rec.Code)
+		}
+	}
+
+	rec := attemptLogin(t, server, "198.51.100.1:1234", "target@test.com", "password123")
+	assertTooManyAttempts(t, rec, 15*time.Minute)
+}
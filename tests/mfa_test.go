@@ -0,0 +1,183 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+	"github.com/sunfmin/shadcn-admin-go/services"
+)
+
+func TestTOTPCode(t *testing.T) {
+	// RFC 6238 appendix B vectors for SHA-1, truncated to six digits
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ" // base32("12345678901234567890")
+	testCases := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 20000000000, want: "353130"},
+	}
+
+	for _, tc := range testCases {
+		got, err := services.TOTPCode(secret, time.Unix(tc.unix, 0))
+		if err != nil {
+			t.Fatalf("TOTPCode(%d): %v", tc.unix, err)
+		}
+		if got != tc.want {
+			t.Errorf("TOTPCode(%d) = %s, want %s", tc.unix, got, tc.want)
+		}
+	}
+}
+
+func TestMFALogin(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "refresh_tokens", "user_mfas", "mfa_recovery_codes", "mfa_challenges")
+
+	user := createTestUser(t, db, "admin@test.com", "password123", "admin")
+	superadmin := createTestUser(t, db, "root@test.com", "password123", "superadmin")
+	server := createTestServer(t, db)
+	token := createTestAccessToken(t, db, user)
+
+	// code returns the authenticator code offset steps from now
+	code := func(t *testing.T, secret string, offset int) string {
+		t.Helper()
+		c, err := services.TOTPCode(secret, time.Now().Add(time.Duration(offset)*30*time.Second))
+		if err != nil {
+			t.Fatalf("Failed to compute TOTP code: %v", err)
+		}
+		return c
+	}
+
+	// passwordStep logs in and returns the MFA challenge token
+	passwordStep := func(t *testing.T) string {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, newAPIRequest(t, "POST", "/auth/login", &api.LoginRequest{Email: "admin@test.com", Password: "password123"}))
+		if rec.Code != http.StatusAccepted {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusAccepted, rec.Code, rec.Body.String())
+		}
+		var challenge api.MfaChallenge
+		json.Unmarshal(rec.Body.Bytes(), &challenge)
+		if challenge.MfaToken == "" {
+			t.Fatal("Expected an MFA token")
+		}
+		return challenge.MfaToken
+	}
+
+	// verify submits the second step and returns the recorder
+	verify := func(t *testing.T, req *api.VerifyMfaRequest) *httptest.ResponseRecorder {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, newAPIRequest(t, "POST", "/auth/mfa/verify", req))
+		return rec
+	}
+
+	var (
+		secret        string
+		recoveryCodes []string
+	)
+
+	t.Run("enrol and confirm", func(t *testing.T) {
+		rec := doWithToken(server, httptest.NewRequest("POST", "/auth/mfa/enroll", nil), token)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		var enrollment api.MfaEnrollment
+		json.Unmarshal(rec.Body.Bytes(), &enrollment)
+		secret = enrollment.Secret
+
+		uri, err := url.Parse(enrollment.OtpauthUri)
+		if err != nil || uri.Scheme != "otpauth" || uri.Query().Get("secret") != secret {
+			t.Errorf("Unexpected otpauth URI %q", enrollment.OtpauthUri)
+		}
+
+		req := newAPIRequest(t, "POST", "/auth/mfa/confirm", &api.ConfirmMfaRequest{Code: "000000"})
+		if rec := doWithToken(server, req, token); rec.Code != http.StatusBadRequest {
+			t.Errorf("Expected wrong code to get %d, got %d", http.StatusBadRequest, rec.Code)
+		}
+
+		req = newAPIRequest(t, "POST", "/auth/mfa/confirm", &api.ConfirmMfaRequest{Code: code(t, secret, 0)})
+		rec = doWithToken(server, req, token)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		var codes api.MfaRecoveryCodes
+		json.Unmarshal(rec.Body.Bytes(), &codes)
+		recoveryCodes = codes.RecoveryCodes
+		if len(recoveryCodes) != 10 {
+			t.Errorf("Expected 10 recovery codes, got %d", len(recoveryCodes))
+		}
+
+		rec = doWithToken(server, httptest.NewRequest("POST", "/auth/mfa/enroll", nil), token)
+		if rec.Code != http.StatusConflict {
+			t.Errorf("Expected re-enrolment to get %d, got %d", http.StatusConflict, rec.Code)
+		}
+	})
+
+	t.Run("password alone yields a challenge", func(t *testing.T) {
+		mfaToken := passwordStep(t)
+
+		// The code used for confirmation cannot be replayed
+		rec := verify(t, &api.VerifyMfaRequest{MfaToken: mfaToken, Code: api.NewOptString(code(t, secret, 0))})
+		if rec.Code != http.StatusUnauthorized {
+			t.Fatalf("Expected replayed code to get %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+		var response api.ErrorResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		if response.Code != handlers.Errors.MFAFailed.Code {
+			t.Errorf("Expected error code %s, got %s", handlers.Errors.MFAFailed.Code, response.Code)
+		}
+
+		// The next step is still inside the ±1 window
+		rec = verify(t, &api.VerifyMfaRequest{MfaToken: mfaToken, Code: api.NewOptString(code(t, secret, 1))})
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		var login api.LoginResponse
+		json.Unmarshal(rec.Body.Bytes(), &login)
+		if login.AccessToken == "" {
+			t.Error("Expected an access token")
+		}
+
+		// The challenge is single-use
+		rec = verify(t, &api.VerifyMfaRequest{MfaToken: mfaToken, Code: api.NewOptString(code(t, secret, 1))})
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected used challenge to get %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+	})
+
+	t.Run("recovery codes are single-use", func(t *testing.T) {
+		rec := verify(t, &api.VerifyMfaRequest{MfaToken: passwordStep(t), RecoveryCode: api.NewOptString(recoveryCodes[0])})
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+
+		rec = verify(t, &api.VerifyMfaRequest{MfaToken: passwordStep(t), RecoveryCode: api.NewOptString(recoveryCodes[0])})
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected reused recovery code to get %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+	})
+
+	t.Run("only a superadmin can reset MFA", func(t *testing.T) {
+		path := "/users/" + user.ID.String() + "/mfa"
+		if rec := doWithToken(server, httptest.NewRequest("DELETE", path, nil), token); rec.Code != http.StatusForbidden {
+			t.Fatalf("Expected admin to get %d, got %d", http.StatusForbidden, rec.Code)
+		}
+
+		rec := doWithToken(server, httptest.NewRequest("DELETE", path, nil), createTestAccessToken(t, db, superadmin))
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusNoContent, rec.Code, rec.Body.String())
+		}
+
+		loginTestUser(t, server, "admin@test.com", "password123")
+	})
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+)
+
+func TestUserCursorPagination(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions")
+
+	server := createAuthorizedTestServer(t, db)
+	for _, email := range []string{"bob@test.com", "dave@test.com", "alice@test.com", "carol@test.com"} {
+		createTestUser(t, db, email, "password123", "cashier")
+	}
+
+	first := listUsers(t, server, "?sort=username&pageSize=2")
+	if got := usernames(first.Data); !slices.Equal(got, []string{"admin", "alice"}) {
+		t.Fatalf("Unexpected first page %v", got)
+	}
+	if first.Cursors.Value.Prev.IsSet() || !first.Cursors.Value.Next.IsSet() {
+		t.Fatalf("Expected only a next cursor on the first page, got %+v", first.Cursors)
+	}
+
+	// A user inserted before the current position does not shift later pages
+	createTestUser(t, db, "aaron@test.com", "password123", "cashier")
+
+	second := listUsers(t, server, "?sort=username&pageSize=2&cursor="+url.QueryEscape(first.Cursors.Value.Next.Value))
+	if got := usernames(second.Data); !slices.Equal(got, []string{"bob", "carol"}) {
+		t.Fatalf("Unexpected second page %v", got)
+	}
+	if second.Meta.Page != 2 || second.Meta.Total != 6 || second.Meta.TotalPages != 3 {
+		t.Errorf("Expected cursor pages to keep page numbers and totals, got %+v", second.Meta)
+	}
+
+	last := listUsers(t, server, "?sort=username&pageSize=2&cursor="+url.QueryEscape(second.Cursors.Value.Next.Value))
+	if got := usernames(last.Data); !slices.Equal(got, []string{"dave"}) {
+		t.Fatalf("Unexpected last page %v", got)
+	}
+	if last.Cursors.Value.Next.IsSet() {
+		t.Error("Expected no next cursor on the last page")
+	}
+
+	back := listUsers(t, server, "?sort=username&pageSize=2&cursor="+url.QueryEscape(last.Cursors.Value.Prev.Value))
+	if got := usernames(back.Data); !slices.Equal(got, []string{"bob", "carol"}) {
+		t.Fatalf("Expected prev cursor to return the second page, got %v", got)
+	}
+	if !back.Cursors.Value.Prev.IsSet() {
+		t.Error("Expected a prev cursor while earlier users remain")
+	}
+	if back.Meta.Page != 2 {
+		t.Errorf("Expected prev cursor to report page 2, got %d", back.Meta.Page)
+	}
+
+	t.Run("page numbers keep working", func(t *testing.T) {
+		response := listUsers(t, server, "?sort=username&pageSize=2&page=2")
+		if response.Meta.Page != 2 || response.Meta.Total != 6 || response.Meta.TotalPages != 3 {
+			t.Errorf("Unexpected meta %+v", response.Meta)
+		}
+		if !response.Cursors.Value.Prev.IsSet() || !response.Cursors.Value.Next.IsSet() {
+			t.Errorf("Expected cursors for both neighbours, got %+v", response.Cursors)
+		}
+	})
+
+	t.Run("invalid cursors are rejected", func(t *testing.T) {
+		for _, query := range []string{
+			"?sort=username&cursor=not-a-cursor",
+			// Cursors only apply to the ordering they were issued for
+			"?sort=-createdAt&cursor=" + url.QueryEscape(first.Cursors.Value.Next.Value),
+		} {
+			rec := httptest.NewRecorder()
+			server.ServeHTTP(rec, httptest.NewRequest("GET", "/users"+query, nil))
+			assertErrorCode(t, rec, http.StatusBadRequest, handlers.Errors.InvalidCursor.Code)
+		}
+	})
+}
+
+func TestTaskCursorPagination(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "tasks")
+
+	server := createAuthorizedTestServer(t, db)
+	for _, id := range []string{"TASK-0001", "TASK-0002", "TASK-0003"} {
+		createTestTask(t, db, id, "Task "+id, "todo", "feature", "medium")
+	}
+	// Tasks created in the same instant are ordered by ID
+	db.Exec("UPDATE tasks SET created_at = ?", time.Now())
+
+	var ids []string
+	query := "?pageSize=2"
+	for range 3 {
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest("GET", "/tasks"+query, nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		var response api.TaskListResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		for _, task := range response.Data {
+			ids = append(ids, task.ID)
+		}
+		next, ok := response.Cursors.Value.Next.Get()
+		if !ok {
+			break
+		}
+		query = "?pageSize=2&cursor=" + url.QueryEscape(next)
+	}
+
+	if !slices.Equal(ids, []string{"TASK-0001", "TASK-0002", "TASK-0003"}) {
+		t.Errorf("Expected every task exactly once in ID order, got %v", ids)
+	}
+}
+
+func TestPageSizeBounds(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions")
+
+	server := createAuthorizedTestServer(t, db)
+	for _, path := range []string{"/users", "/tasks"} {
+		for _, query := range []string{"?pageSize=0", "?pageSize=-1", "?pageSize=101", "?page=0"} {
+			t.Run(path+query, func(t *testing.T) {
+				rec := httptest.NewRecorder()
+				server.ServeHTTP(rec, httptest.NewRequest("GET", path+query, nil))
+				if rec.Code != http.StatusBadRequest {
+					t.Errorf("Expected status %d, got %d. Body: %s", http.StatusBadRequest, rec.Code, rec.Body.String())
+				}
+			})
+		}
+	}
+}
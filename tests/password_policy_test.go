@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+	"github.com/sunfmin/shadcn-admin-go/services"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPasswordPolicyValidate(t *testing.T) {
+	listPath := filepath.Join(t.TempDir(), "common.txt")
+	if err := os.WriteFile(listPath, []byte("# breached\nPassword1!\n\nqwerty123\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write common password list: %v", err)
+	}
+	common, err := services.LoadCommonPasswords(listPath)
+	if err != nil {
+		t.Fatalf("Failed to load common passwords: %v", err)
+	}
+
+	policy := services.PasswordPolicy{
+		MinLength:     10,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		Common:        common,
+	}
+
+	testCases := []struct {
+		name      string
+		password  string
+		wantCodes []string
+	}{
+		{name: "satisfies every rule", password: "Correct-Horse-9"},
+		{name: "too short", password: "Sh0rt!", wantCodes: []string{services.PasswordTooShort}},
+		{
+			name:      "missing classes",
+			password:  "alllowercaseletters",
+			wantCodes: []string{services.PasswordMissingUppercase, services.PasswordMissingDigit, services.PasswordMissingSymbol},
+		},
+		{name: "listed as common regardless of case", password: "pASSWORD1!", wantCodes: []string{services.PasswordTooCommon}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := policy.Validate("password", tc.password)
+			if tc.wantCodes == nil {
+				if err != nil {
+					t.Fatalf("Expected no violations, got %v", err)
+				}
+				return
+			}
+
+			var policyErr *services.PasswordPolicyError
+			if !errors.As(err, &policyErr) {
+				t.Fatalf("Expected PasswordPolicyError, got %v", err)
+			}
+			if !errors.Is(err, services.ErrPasswordPolicy) {
+				t.Errorf("Expected error to match ErrPasswordPolicy")
+			}
+			var codes []string
+			for _, v := range policyErr.Violations {
+				codes = append(codes, v.Code)
+				if v.Field != "password" {
+					t.Errorf("Expected field password, got %s", v.Field)
+				}
+			}
+			if diff := cmp.Diff(tc.wantCodes, codes); diff != "" {
+				t.Errorf("Violation codes mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPasswordPolicyEnforcement(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "refresh_tokens", "password_histories")
+
+	createTestUser(t, db, "employee@test.com", "password123", "cashier")
+	server := createTestServer(t, db)
+	session := loginTestUser(t, server, "employee@test.com", "password123")
+
+	// change changes the password with the session's token and returns the response
+	change := func(t *testing.T, current, next string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := newAPIRequest(t, "POST", "/auth/password/change", &api.ChangePasswordRequest{CurrentPassword: current, NewPassword: next})
+		return doWithToken(server, req, session.AccessToken)
+	}
+
+	t.Run("violations are returned per field", func(t *testing.T) {
+		rec := change(t, "password123", "short")
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusBadRequest, rec.Code, rec.Body.String())
+		}
+
+		var response api.ErrorResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		if response.Code != handlers.Errors.PasswordPolicy.Code {
+			t.Errorf("Expected error code %s, got %s", handlers.Errors.PasswordPolicy.Code, response.Code)
+		}
+		expected := []api.FieldError{
+			{Field: "newPassword", Code: services.PasswordTooShort},
+			{Field: "newPassword", Code: services.PasswordMissingDigit},
+		}
+		if diff := cmp.Diff(expected, response.Fields, cmpopts.IgnoreFields(api.FieldError{}, "Message")); diff != "" {
+			t.Errorf("Fields mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("recent passwords cannot be reused", func(t *testing.T) {
+		if rec := change(t, "password123", "password123"); rec.Code != http.StatusBadRequest {
+			t.Fatalf("Expected current password to be rejected, got %d", rec.Code)
+		}
+		if rec := change(t, "password123", "rotated456"); rec.Code != http.StatusNoContent {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusNoContent, rec.Code, rec.Body.String())
+		}
+
+		rec := change(t, "rotated456", "password123")
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("Expected previous password to be rejected, got %d", rec.Code)
+		}
+		var response api.ErrorResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		expected := []api.FieldError{{Field: "newPassword", Code: services.PasswordReused}}
+		if diff := cmp.Diff(expected, response.Fields, cmpopts.IgnoreFields(api.FieldError{}, "Message")); diff != "" {
+			t.Errorf("Fields mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestLoginUpgradesPasswordHash(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "refresh_tokens")
+
+	user := createTestUser(t, db, "legacy@test.com", "password123", "cashier")
+	weak, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("Failed to hash password: %v", err)
+	}
+	db.Model(&models.User{}).Where("id = ?", user.ID).Update("password", string(weak))
+
+	server := createTestServer(t, db)
+	loginTestUser(t, server, "legacy@test.com", "password123")
+
+	var stored models.User
+	db.First(&stored, "id = ?", user.ID)
+	cost, err := bcrypt.Cost([]byte(stored.Password))
+	if err != nil {
+		t.Fatalf("Stored password is not a bcrypt hash: %v", err)
+	}
+	if cost != services.DefaultPasswordPolicy().HashCost {
+		t.Errorf("Expected hash cost %d after login, got %d", services.DefaultPasswordPolicy().HashCost, cost)
+	}
+
+	loginTestUser(t, server, "legacy@test.com", "password123")
+}
@@ -0,0 +1,161 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+)
+
+func TestPasswordReset(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "refresh_tokens", "password_resets")
+
+	createTestUser(t, db, "forgetful@test.com", "password123", "cashier")
+	server, mailer := createTestServerWithMailer(t, db)
+
+	// forgot requests a reset link for the email
+	forgot := func(t *testing.T, email string) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, newAPIRequest(t, "POST", "/auth/password/forgot", &api.ForgotPasswordRequest{Email: email}))
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusNoContent, rec.Code, rec.Body.String())
+		}
+	}
+
+	// reset sets a new password with the token and returns the response
+	reset := func(t *testing.T, token, password string) *httptest.ResponseRecorder {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, newAPIRequest(t, "POST", "/auth/password/reset", &api.ResetPasswordRequest{Token: token, Password: password}))
+		return rec
+	}
+
+	t.Run("reset link sets a new password and ends sessions", func(t *testing.T) {
+		session := loginTestUser(t, server, "forgetful@test.com", "password123")
+
+		forgot(t, "forgetful@test.com")
+		token := mailer.tokenFromMail(t, "forgetful@test.com")
+
+		rec := reset(t, token, "brandnew456")
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusNoContent, rec.Code, rec.Body.String())
+		}
+
+		loginTestUser(t, server, "forgetful@test.com", "brandnew456")
+
+		rec = doWithToken(server, httptest.NewRequest("GET", "/auth/me", nil), session.AccessToken)
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected old session to get %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+
+		// The token is single-use
+		rec = reset(t, token, "another789")
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("Expected reused token to get %d, got %d", http.StatusBadRequest, rec.Code)
+		}
+		var response api.ErrorResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		if response.Code != handlers.Errors.InvalidResetToken.Code {
+			t.Errorf("Expected error code %s, got %s", handlers.Errors.InvalidResetToken.Code, response.Code)
+		}
+	})
+
+	t.Run("a new request replaces the previous link", func(t *testing.T) {
+		forgot(t, "forgetful@test.com")
+		oldToken := mailer.tokenFromMail(t, "forgetful@test.com")
+		forgot(t, "forgetful@test.com")
+		newToken := mailer.tokenFromMail(t, "forgetful@test.com")
+
+		if rec := reset(t, oldToken, "newer7890"); rec.Code != http.StatusBadRequest {
+			t.Errorf("Expected old token to get %d, got %d", http.StatusBadRequest, rec.Code)
+		}
+		if rec := reset(t, newToken, "newer7890"); rec.Code != http.StatusNoContent {
+			t.Errorf("Expected new token to get %d, got %d", http.StatusNoContent, rec.Code)
+		}
+	})
+
+	t.Run("users created without a password get a link", func(t *testing.T) {
+		admin := createTestUser(t, db, "admin@test.com", "password123", "superadmin")
+		createReq := &api.CreateUserRequest{FirstName: "New", LastName: "Hire", Email: "newhire@test.com", Role: api.UserRoleCashier}
+		rec := doWithToken(server, newAPIRequest(t, "POST", "/users", createReq), createTestAccessToken(t, db, admin))
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusCreated, rec.Code, rec.Body.String())
+		}
+
+		token := mailer.tokenFromMail(t, "newhire@test.com")
+		if rec := reset(t, token, "firstpass1"); rec.Code != http.StatusNoContent {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusNoContent, rec.Code, rec.Body.String())
+		}
+		loginTestUser(t, server, "newhire@test.com", "firstpass1")
+	})
+
+	t.Run("unknown email does not reveal anything", func(t *testing.T) {
+		forgot(t, "nobody@test.com")
+
+		mailer.mu.Lock()
+		defer mailer.mu.Unlock()
+		for _, msg := range mailer.messages {
+			if msg.To == "nobody@test.com" {
+				t.Errorf("Expected no mail to unknown address, got %q", msg.Subject)
+			}
+		}
+	})
+}
+
+func TestChangePassword(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "refresh_tokens")
+
+	createTestUser(t, db, "employee@test.com", "password123", "cashier")
+	server := createTestServer(t, db)
+
+	current := loginTestUser(t, server, "employee@test.com", "password123")
+	other := loginTestUser(t, server, "employee@test.com", "password123")
+
+	t.Run("wrong current password is rejected", func(t *testing.T) {
+		req := newAPIRequest(t, "POST", "/auth/password/change", &api.ChangePasswordRequest{CurrentPassword: "wrongpass", NewPassword: "brandnew456"})
+		rec := doWithToken(server, req, current.AccessToken)
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusBadRequest, rec.Code, rec.Body.String())
+		}
+		var response api.ErrorResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		if response.Code != handlers.Errors.IncorrectPassword.Code {
+			t.Errorf("Expected error code %s, got %s", handlers.Errors.IncorrectPassword.Code, response.Code)
+		}
+	})
+
+	t.Run("change keeps only the current session", func(t *testing.T) {
+		req := newAPIRequest(t, "POST", "/auth/password/change", &api.ChangePasswordRequest{CurrentPassword: "password123", NewPassword: "brandnew456"})
+		rec := doWithToken(server, req, current.AccessToken)
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusNoContent, rec.Code, rec.Body.String())
+		}
+
+		rec = doWithToken(server, httptest.NewRequest("GET", "/auth/me", nil), current.AccessToken)
+		if rec.Code != http.StatusOK {
+			t.Errorf("Expected current session to stay valid, got %d", rec.Code)
+		}
+		rec = doWithToken(server, httptest.NewRequest("GET", "/auth/me", nil), other.AccessToken)
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected other session to get %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+
+		loginTestUser(t, server, "employee@test.com", "brandnew456")
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, newAPIRequest(t, "POST", "/auth/password/change", &api.ChangePasswordRequest{CurrentPassword: "brandnew456", NewPassword: "password123"}))
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+)
+
+func TestRoleBasedAccess(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions")
+
+	server := createTestServer(t, db)
+	tokens := map[string]string{}
+	for _, role := range []string{"superadmin", "admin", "manager", "cashier"} {
+		user := createTestUser(t, db, role+"@test.com", "password123", role)
+		tokens[role] = createTestAccessToken(t, db, user)
+	}
+	target := createTestUser(t, db, "target@test.com", "password123", "cashier")
+
+	testCases := []struct {
+		name       string
+		role       string
+		method     string
+		path       string
+		wantStatus int
+	}{
+		{name: "cashier lists tasks", role: "cashier", method: "GET", path: "/tasks", wantStatus: http.StatusOK},
+		{name: "cashier reads dashboard", role: "cashier", method: "GET", path: "/dashboard/stats", wantStatus: http.StatusOK},
+		{name: "cashier cannot list users", role: "cashier", method: "GET", path: "/users", wantStatus: http.StatusForbidden},
+		{name: "cashier cannot delete users", role: "cashier", method: "DELETE", path: "/users/" + target.ID.String(), wantStatus: http.StatusForbidden},
+		{name: "manager lists users", role: "manager", method: "GET", path: "/users", wantStatus: http.StatusOK},
+		{name: "manager cannot delete users", role: "manager", method: "DELETE", path: "/users/" + target.ID.String(), wantStatus: http.StatusForbidden},
+		{name: "admin revokes sessions", role: "admin", method: "DELETE", path: "/users/" + target.ID.String() + "/sessions", wantStatus: http.StatusNoContent},
+		{name: "admin deletes users", role: "admin", method: "DELETE", path: "/users/" + target.ID.String(), wantStatus: http.StatusNoContent},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := doWithToken(server, httptest.NewRequest(tc.method, tc.path, nil), tokens[tc.role])
+
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("Expected status %d, got %d. Body: %s", tc.wantStatus, rec.Code, rec.Body.String())
+			}
+
+			if tc.wantStatus == http.StatusForbidden {
+				var response api.ErrorResponse
+				json.Unmarshal(rec.Body.Bytes(), &response)
+				if response.Code != handlers.Errors.Forbidden.Code {
+					t.Errorf("Expected error code %s, got %s", handlers.Errors.Forbidden.Code, response.Code)
+				}
+			}
+		})
+	}
+
+	t.Run("role changes apply to existing tokens", func(t *testing.T) {
+		db.Exec("UPDATE users SET role = 'cashier' WHERE email = 'manager@test.com'")
+
+		rec := doWithToken(server, httptest.NewRequest("GET", "/users", nil), tokens["manager"])
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("Expected status %d, got %d", http.StatusForbidden, rec.Code)
+		}
+	})
+}
+
+func TestSuperadminProtection(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "invitations")
+
+	root := createTestUser(t, db, "root@test.com", "password123", "superadmin")
+	admin := createTestUser(t, db, "admin@test.com", "password123", "admin")
+	alice := createTestUser(t, db, "alice@test.com", "password123", "cashier")
+	server := createTestServer(t, db)
+	adminToken := createTestAccessToken(t, db, admin)
+	rootPath := "/users/" + root.ID.String()
+	invited := createTestUser(t, db, "invited@test.com", "password123", "superadmin")
+	db.Model(invited).Update("status", "invited")
+	invitedPath := "/users/" + invited.ID.String()
+
+	testCases := []struct {
+		name string
+		req  *http.Request
+	}{
+		{name: "promote self", req: newAPIRequest(t, "PUT", "/users/"+admin.ID.String(), &api.UpdateUserRequest{Role: api.NewOptUserRole(api.UserRoleSuperadmin)})},
+		{name: "promote another user", req: newAPIRequest(t, "PUT", "/users/"+alice.ID.String(), &api.UpdateUserRequest{Role: api.NewOptUserRole(api.UserRoleSuperadmin)})},
+		{name: "create a superadmin", req: newAPIRequest(t, "POST", "/users", &api.CreateUserRequest{FirstName: "New", LastName: "Root", Email: "new@test.com", Role: api.UserRoleSuperadmin})},
+		{name: "invite a superadmin", req: newAPIRequest(t, "POST", "/users/invite", &api.InviteUserRequest{Email: "new@test.com", Role: api.UserRoleSuperadmin})},
+		{name: "edit a superadmin", req: newAPIRequest(t, "PUT", rootPath, &api.UpdateUserRequest{FirstName: api.NewOptString("Renamed")})},
+		{name: "suspend a superadmin", req: newAPIRequest(t, "PUT", rootPath, &api.UpdateUserRequest{Status: api.NewOptUserStatus(api.UserStatusSuspended)})},
+		{name: "demote a superadmin", req: newAPIRequest(t, "PUT", rootPath, &api.UpdateUserRequest{Role: api.NewOptUserRole(api.UserRoleCashier)})},
+		{name: "delete a superadmin", req: httptest.NewRequest("DELETE", rootPath, nil)},
+		{name: "unlock a superadmin", req: httptest.NewRequest("POST", rootPath+"/unlock", nil)},
+		{name: "revoke a superadmin's sessions", req: httptest.NewRequest("DELETE", rootPath+"/sessions", nil)},
+		{name: "revoke a superadmin's session", req: httptest.NewRequest("DELETE", rootPath+"/sessions/any", nil)},
+		{name: "resend a superadmin invitation", req: httptest.NewRequest("POST", invitedPath+"/invitation/resend", nil)},
+		{name: "revoke a superadmin invitation", req: httptest.NewRequest("DELETE", invitedPath+"/invitation", nil)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := doWithToken(server, tc.req, adminToken)
+			assertErrorCode(t, rec, http.StatusForbidden, handlers.Errors.Forbidden.Code)
+		})
+	}
+
+	t.Run("import a superadmin", func(t *testing.T) {
+		csv := "email,firstName,lastName,role\nnew@test.com,New,Root,superadmin\nok@test.com,Ok,User,cashier\n"
+		report := importUsers(t, server, adminToken, csv, true, false)
+		if report.Valid != 1 || len(report.Errors) != 1 || report.Errors[0].Row != 2 || report.Errors[0].Field.Or("") != "role" {
+			t.Errorf("Expected a role error on row 2 and one valid row, got %+v", report)
+		}
+	})
+
+	var count int64
+	db.Table("users").Where("role = ? AND deleted_at IS NULL", "superadmin").Count(&count)
+	if count != 2 {
+		t.Errorf("Expected only the original superadmins, got %d superadmins", count)
+	}
+
+	t.Run("superadmins may grant superadmin", func(t *testing.T) {
+		req := newAPIRequest(t, "PUT", "/users/"+alice.ID.String(), &api.UpdateUserRequest{Role: api.NewOptUserRole(api.UserRoleSuperadmin)})
+		if rec := doWithToken(server, req, createTestAccessToken(t, db, root)); rec.Code != http.StatusOK {
+			t.Errorf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+	})
+}
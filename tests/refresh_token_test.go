@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+)
+
+func TestRefreshToken(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "refresh_tokens")
+
+	createTestUser(t, db, "shift@test.com", "password123", "cashier")
+	server := createTestServer(t, db)
+
+	// refresh posts a refresh token and returns the recorder
+	refresh := func(t *testing.T, token string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := newAPIRequest(t, "POST", "/auth/refresh", &api.RefreshTokenRequest{RefreshToken: token})
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// errorCode decodes the error code from an error response
+	errorCode := func(rec *httptest.ResponseRecorder) string {
+		var response api.ErrorResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		return response.Code
+	}
+
+	login := loginTestUser(t, server, "shift@test.com", "password123")
+	firstRefresh, ok := login.RefreshToken.Get()
+	if !ok || firstRefresh == "" {
+		t.Fatal("Expected login to return a refresh token")
+	}
+
+	var rotated api.LoginResponse
+
+	t.Run("refresh rotates the token pair", func(t *testing.T) {
+		rec := refresh(t, firstRefresh)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &rotated); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		if got, _ := rotated.RefreshToken.Get(); got == "" || got == firstRefresh {
+			t.Errorf("Expected a new refresh token, got %q", got)
+		}
+
+		rec = doWithToken(server, httptest.NewRequest("GET", "/auth/me", nil), rotated.AccessToken)
+		if rec.Code != http.StatusOK {
+			t.Errorf("Expected refreshed access token to work, got %d", rec.Code)
+		}
+	})
+
+	t.Run("unknown refresh token", func(t *testing.T) {
+		rec := refresh(t, "not-a-refresh-token")
+		if rec.Code != http.StatusUnauthorized {
+			t.Fatalf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+		if code := errorCode(rec); code != handlers.Errors.InvalidRefreshToken.Code {
+			t.Errorf("Expected error code %s, got %s", handlers.Errors.InvalidRefreshToken.Code, code)
+		}
+	})
+
+	t.Run("reuse revokes the whole family", func(t *testing.T) {
+		rec := refresh(t, firstRefresh)
+		if rec.Code != http.StatusUnauthorized {
+			t.Fatalf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+		if code := errorCode(rec); code != handlers.Errors.RefreshTokenReused.Code {
+			t.Errorf("Expected error code %s, got %s", handlers.Errors.RefreshTokenReused.Code, code)
+		}
+
+		// The legitimately rotated tokens are revoked along with the family
+		latest, _ := rotated.RefreshToken.Get()
+		if rec := refresh(t, latest); rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected rotated refresh token to be revoked, got %d", rec.Code)
+		}
+		rec = doWithToken(server, httptest.NewRequest("GET", "/auth/me", nil), rotated.AccessToken)
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected access token of revoked family to fail, got %d", rec.Code)
+		}
+	})
+
+	t.Run("logout revokes the refresh token", func(t *testing.T) {
+		fresh := loginTestUser(t, server, "shift@test.com", "password123")
+		rec := doWithToken(server, httptest.NewRequest("POST", "/auth/logout", nil), fresh.AccessToken)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+
+		token, _ := fresh.RefreshToken.Get()
+		if rec := refresh(t, token); rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected refresh after logout to fail, got %d", rec.Code)
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+)
+
+func TestSessions(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions")
+
+	admin := createTestUser(t, db, "admin@test.com", "password123", "superadmin")
+	user := createTestUser(t, db, "employee@test.com", "password123", "cashier")
+	server := createTestServer(t, db)
+	adminToken := createTestAccessToken(t, db, admin)
+
+	first := loginTestUser(t, server, "employee@test.com", "password123")
+	second := loginTestUser(t, server, "employee@test.com", "password123")
+	sessionsPath := "/users/" + user.ID.String() + "/sessions"
+
+	t.Run("login tokens are accepted", func(t *testing.T) {
+		rec := doWithToken(server, httptest.NewRequest("GET", "/auth/me", nil), first.AccessToken)
+		if rec.Code != http.StatusOK {
+			t.Errorf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+	})
+
+	t.Run("list sessions", func(t *testing.T) {
+		rec := doWithToken(server, httptest.NewRequest("GET", sessionsPath, nil), adminToken)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+
+		var response api.SessionListResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+
+		expected := []api.Session{{UserId: user.ID}, {UserId: user.ID}}
+		opts := cmp.Options{
+			cmpopts.IgnoreFields(api.Session{}, "ID", "CreatedAt", "ExpiresAt"),
+		}
+		if diff := cmp.Diff(expected, response.Data, opts...); diff != "" {
+			t.Errorf("Sessions mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("logout invalidates only that token", func(t *testing.T) {
+		rec := doWithToken(server, httptest.NewRequest("POST", "/auth/logout", nil), first.AccessToken)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+
+		rec = doWithToken(server, httptest.NewRequest("GET", "/auth/me", nil), first.AccessToken)
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected logged out token to get %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+
+		rec = doWithToken(server, httptest.NewRequest("GET", "/auth/me", nil), second.AccessToken)
+		if rec.Code != http.StatusOK {
+			t.Errorf("Expected other session to stay valid, got %d", rec.Code)
+		}
+	})
+
+	t.Run("revoke unknown session", func(t *testing.T) {
+		rec := doWithToken(server, httptest.NewRequest("DELETE", sessionsPath+"/does-not-exist", nil), adminToken)
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("Expected status %d, got %d", http.StatusNotFound, rec.Code)
+		}
+	})
+
+	t.Run("revoke all sessions", func(t *testing.T) {
+		rec := doWithToken(server, httptest.NewRequest("DELETE", sessionsPath, nil), adminToken)
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusNoContent, rec.Code, rec.Body.String())
+		}
+
+		rec = doWithToken(server, httptest.NewRequest("GET", "/auth/me", nil), second.AccessToken)
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected revoked token to get %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+	})
+
+	t.Run("sessions of unknown user", func(t *testing.T) {
+		rec := doWithToken(server, httptest.NewRequest("GET", "/users/00000000-0000-0000-0000-000000000000/sessions", nil), adminToken)
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("Expected status %d, got %d", http.StatusNotFound, rec.Code)
+		}
+	})
+}
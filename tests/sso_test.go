@@ -0,0 +1,392 @@
+package tests
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+	"github.com/sunfmin/shadcn-admin-go/services"
+	"gorm.io/gorm"
+)
+
+const (
+	testOIDCClientID     = "admin-console"
+	testOIDCClientSecret = "console-secret"
+	testOIDCRedirectURL  = "http://localhost:5173/sso/callback"
+)
+
+// testIdP is a stand-in OpenID Connect provider. Tests play the user's part
+// by calling authorize, which returns the code the provider would redirect with.
+type testIdP struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+
+	mu sync.Mutex
+	// signingKey signs issued ID tokens; tests swap it to forge signatures
+	signingKey *rsa.PrivateKey
+	grants     map[string]testIdPGrant
+}
+
+// testIdPGrant is an authorization code waiting to be redeemed
+type testIdPGrant struct {
+	claims    map[string]interface{}
+	challenge string
+}
+
+// newTestIdP starts a provider serving discovery, JWKS and the token endpoint
+func newTestIdP(t *testing.T) *testIdP {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate IdP key: %v", err)
+	}
+	idp := &testIdP{key: key, signingKey: key, grants: map[string]testIdPGrant{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.URL,
+			"authorization_endpoint": idp.URL + "/authorize",
+			"token_endpoint":         idp.URL + "/token",
+			"jwks_uri":               idp.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "idp-key",
+				"use": "sig",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("POST /token", idp.token)
+	idp.Server = httptest.NewServer(mux)
+	t.Cleanup(idp.Close)
+	return idp
+}
+
+// token redeems an authorization code, checking the client and PKCE verifier
+func (idp *testIdP) token(w http.ResponseWriter, r *http.Request) {
+	clientID, secret, _ := r.BasicAuth()
+	code := r.PostFormValue("code")
+
+	idp.mu.Lock()
+	grant, ok := idp.grants[code]
+	delete(idp.grants, code)
+	signingKey := idp.signingKey
+	idp.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+	if !ok || clientID != testOIDCClientID || secret != testOIDCClientSecret ||
+		r.PostFormValue("grant_type") != "authorization_code" ||
+		r.PostFormValue("redirect_uri") != testOIDCRedirectURL ||
+		base64.RawURLEncoding.EncodeToString(sum[:]) != grant.challenge {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string]string{
+		"access_token": "idp-access-token",
+		"token_type":   "Bearer",
+		"id_token":     signTestIDToken(signingKey, grant.claims),
+	})
+}
+
+// authorize stands in for the user signing in at the provider. It returns the
+// code and state the provider would redirect back with, for an ID token
+// carrying the given claims on top of valid defaults.
+func (idp *testIdP) authorize(t *testing.T, authorizationURL string, claims map[string]interface{}) (string, string) {
+	t.Helper()
+	u, err := url.Parse(authorizationURL)
+	if err != nil {
+		t.Fatalf("Failed to parse authorization URL: %v", err)
+	}
+	query := u.Query()
+	if query.Get("client_id") != testOIDCClientID || query.Get("response_type") != "code" ||
+		query.Get("code_challenge_method") != "S256" || query.Get("redirect_uri") != testOIDCRedirectURL {
+		t.Fatalf("Unexpected authorization request %s", authorizationURL)
+	}
+
+	now := time.Now()
+	full := map[string]interface{}{
+		"iss":   idp.URL,
+		"aud":   testOIDCClientID,
+		"sub":   "idp-user-1",
+		"iat":   now.Unix(),
+		"exp":   now.Add(5 * time.Minute).Unix(),
+		"nonce": query.Get("nonce"),
+	}
+	for name, value := range claims {
+		full[name] = value
+	}
+
+	code := rand.Text()
+	idp.mu.Lock()
+	idp.grants[code] = testIdPGrant{claims: full, challenge: query.Get("code_challenge")}
+	idp.mu.Unlock()
+	return code, query.Get("state")
+}
+
+// signTestIDToken returns a compact RS256 JWS of the claims
+func signTestIDToken(key *rsa.PrivateKey, claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": "idp-key"})
+	payload, _ := json.Marshal(claims)
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, _ := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// createSSOTestServer creates a test server whose single sign-on uses the IdP
+func createSSOTestServer(t *testing.T, db *gorm.DB, idp *testIdP, autoProvision bool) *api.Server {
+	t.Helper()
+
+	authService := services.NewAuthService(db).
+		WithTokenService(createTestTokenService()).
+		WithOIDC(services.OIDCConfig{
+			Issuer:        idp.URL,
+			ClientID:      testOIDCClientID,
+			ClientSecret:  testOIDCClientSecret,
+			RedirectURL:   testOIDCRedirectURL,
+			Scopes:        []string{"email", "profile"},
+			AutoProvision: autoProvision,
+			DefaultRole:   "cashier",
+		}).
+		Build()
+	handler := services.NewOgenHandler().
+		WithAuthService(authService).
+		WithUserService(services.NewUserService(db).Build()).
+		Build()
+
+	server, err := handlers.NewServer(handler)
+	if err != nil {
+		t.Fatalf("Failed to create server: %v", err)
+	}
+	return server
+}
+
+// startSSO begins a sign-on and returns the authorization URL
+func startSSO(t *testing.T, server http.Handler) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest("POST", "/auth/sso/start", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	var authorization api.SsoAuthorization
+	json.Unmarshal(rec.Body.Bytes(), &authorization)
+	return authorization.AuthorizationUrl.String()
+}
+
+// completeSSO posts the provider's callback parameters and returns the recorder
+func completeSSO(t *testing.T, server http.Handler, code, state string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, newAPIRequest(t, "POST", "/auth/sso/callback", &api.SsoCallbackRequest{Code: code, State: state}))
+	return rec
+}
+
+// assertErrorCode checks the status and error code of a failed response
+func assertErrorCode(t *testing.T, rec *httptest.ResponseRecorder, status int, code string) {
+	t.Helper()
+	if rec.Code != status {
+		t.Fatalf("Expected status %d, got %d. Body: %s", status, rec.Code, rec.Body.String())
+	}
+	var response api.ErrorResponse
+	json.Unmarshal(rec.Body.Bytes(), &response)
+	if response.Code != code {
+		t.Errorf("Expected error code %s, got %s", code, response.Code)
+	}
+}
+
+func TestSSOLogin(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "refresh_tokens", "sso_states")
+
+	user := createTestUser(t, db, "staff@test.com", "password123", "admin")
+	suspended := createTestUser(t, db, "gone@test.com", "password123", "cashier")
+	db.Model(suspended).Update("status", "suspended")
+
+	idp := newTestIdP(t)
+	server := createSSOTestServer(t, db, idp, false)
+
+	t.Run("existing user signs in by email", func(t *testing.T) {
+		code, state := idp.authorize(t, startSSO(t, server), map[string]interface{}{
+			"email":          "Staff@Test.com",
+			"email_verified": true,
+		})
+		rec := completeSSO(t, server, code, state)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		var response api.LoginResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		if response.User.AccountNo != user.ID.String() {
+			t.Errorf("Expected account %s, got %s", user.ID, response.User.AccountNo)
+		}
+
+		// The issued token works like one from a password login
+		req := httptest.NewRequest("GET", "/auth/me", nil)
+		if rec := doWithToken(server, req, response.AccessToken); rec.Code != http.StatusOK {
+			t.Errorf("Expected /auth/me status %d, got %d", http.StatusOK, rec.Code)
+		}
+
+		// Replaying the same callback fails: the state was consumed
+		assertErrorCode(t, completeSSO(t, server, code, state), http.StatusUnauthorized, handlers.Errors.SSOFailed.Code)
+	})
+
+	testCases := []struct {
+		name       string
+		claims     map[string]interface{}
+		forge      bool
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "unknown email without provisioning",
+			claims:     map[string]interface{}{"email": "stranger@test.com", "email_verified": true},
+			wantStatus: http.StatusForbidden,
+			wantCode:   handlers.Errors.SSOAccountNotFound.Code,
+		},
+		{
+			name:       "suspended account",
+			claims:     map[string]interface{}{"email": "gone@test.com", "email_verified": true},
+			wantStatus: http.StatusForbidden,
+			wantCode:   handlers.Errors.AccountSuspended.Code,
+		},
+		{
+			name:       "unverified email",
+			claims:     map[string]interface{}{"email": "staff@test.com", "email_verified": false},
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   handlers.Errors.SSOFailed.Code,
+		},
+		{
+			name:       "email not marked as verified",
+			claims:     map[string]interface{}{"email": "staff@test.com"},
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   handlers.Errors.SSOFailed.Code,
+		},
+		{
+			name:       "nonce mismatch",
+			claims:     map[string]interface{}{"email": "staff@test.com", "nonce": "replayed"},
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   handlers.Errors.SSOFailed.Code,
+		},
+		{
+			name:       "token for another client",
+			claims:     map[string]interface{}{"email": "staff@test.com", "aud": "other-app"},
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   handlers.Errors.SSOFailed.Code,
+		},
+		{
+			name:       "token from another issuer",
+			claims:     map[string]interface{}{"email": "staff@test.com", "iss": "https://evil.example.com"},
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   handlers.Errors.SSOFailed.Code,
+		},
+		{
+			name:       "expired token",
+			claims:     map[string]interface{}{"email": "staff@test.com", "exp": time.Now().Add(-time.Hour).Unix()},
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   handlers.Errors.SSOFailed.Code,
+		},
+		{
+			name:       "signature not from the provider's keys",
+			claims:     map[string]interface{}{"email": "staff@test.com"},
+			forge:      true,
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   handlers.Errors.SSOFailed.Code,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.forge {
+				rogue, err := rsa.GenerateKey(rand.Reader, 2048)
+				if err != nil {
+					t.Fatalf("Failed to generate key: %v", err)
+				}
+				idp.mu.Lock()
+				idp.signingKey = rogue
+				idp.mu.Unlock()
+				defer func() {
+					idp.mu.Lock()
+					idp.signingKey = idp.key
+					idp.mu.Unlock()
+				}()
+			}
+
+			code, state := idp.authorize(t, startSSO(t, server), tc.claims)
+			assertErrorCode(t, completeSSO(t, server, code, state), tc.wantStatus, tc.wantCode)
+		})
+	}
+
+	t.Run("unknown state", func(t *testing.T) {
+		code, _ := idp.authorize(t, startSSO(t, server), map[string]interface{}{"email": "staff@test.com"})
+		assertErrorCode(t, completeSSO(t, server, code, "not-a-state"), http.StatusUnauthorized, handlers.Errors.SSOFailed.Code)
+	})
+}
+
+func TestSSOAutoProvision(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "refresh_tokens", "sso_states")
+
+	idp := newTestIdP(t)
+	server := createSSOTestServer(t, db, idp, true)
+
+	code, state := idp.authorize(t, startSSO(t, server), map[string]interface{}{
+		"email":          "newhire@test.com",
+		"email_verified": true,
+		"given_name":     "New",
+		"family_name":    "Hire",
+	})
+	rec := completeSSO(t, server, code, state)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	var user models.User
+	if err := db.Where("email = ?", "newhire@test.com").First(&user).Error; err != nil {
+		t.Fatalf("Expected provisioned user: %v", err)
+	}
+	if user.Role != "cashier" || user.Status != "active" || user.FirstName != "New" || user.LastName != "Hire" {
+		t.Errorf("Unexpected provisioned user %+v", user)
+	}
+
+	// Provisioned accounts cannot log in with a password
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, newAPIRequest(t, "POST", "/auth/login", &api.LoginRequest{Email: "newhire@test.com", Password: "anything"}))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected password login status %d, got %d", http.StatusUnauthorized, rec.Code)
+	}
+}
+
+func TestSSONotConfigured(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	server := createTestServer(t, db)
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest("POST", "/auth/sso/start", nil))
+	assertErrorCode(t, rec, http.StatusNotFound, handlers.Errors.SSONotConfigured.Code)
+}
@@ -1,12 +1,20 @@
 package tests
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
 	"testing"
 	"time"
 
 	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
 	"github.com/sunfmin/shadcn-admin-go/internal/models"
 	"github.com/sunfmin/shadcn-admin-go/services"
 	"github.com/testcontainers/testcontainers-go"
@@ -177,14 +185,94 @@ func createTestChatMessage(t *testing.T, db *gorm.DB, chatID, sender, message st
 	return msg
 }
 
+// testSigningKey is the HMAC secret used to sign access tokens in tests
+var testSigningKey = []byte("test-signing-key-0123456789abcdef")
+
+// createTestTokenService creates a TokenService signing with testSigningKey
+func createTestTokenService() services.TokenService {
+	tokenService, err := services.NewTokenService().
+		WithKey("test", testSigningKey).
+		Build()
+	if err != nil {
+		panic(fmt.Sprintf("Failed to build token service: %v", err))
+	}
+	return tokenService
+}
+
+// testMailer records sent messages instead of delivering them
+type testMailer struct {
+	mu       sync.Mutex
+	messages []services.MailMessage
+	// failing makes every delivery fail
+	failing bool
+}
+
+// Send implements services.Mailer
+func (m *testMailer) Send(ctx context.Context, msg services.MailMessage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.failing {
+		return errors.New("mail server unavailable")
+	}
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+// setFailing makes deliveries fail or succeed again
+func (m *testMailer) setFailing(failing bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.failing = failing
+}
+
+// lastTo returns the most recent message sent to the address
+func (m *testMailer) lastTo(t *testing.T, to string) services.MailMessage {
+	t.Helper()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := len(m.messages) - 1; i >= 0; i-- {
+		if m.messages[i].To == to {
+			return m.messages[i]
+		}
+	}
+	t.Fatalf("No mail sent to %s", to)
+	return services.MailMessage{}
+}
+
+// mailTokenPattern extracts the token query parameter from an emailed link
+var mailTokenPattern = regexp.MustCompile(`token=([A-Za-z0-9_-]+)`)
+
+// tokenFromMail extracts the token from the most recent link mailed to the address
+func (m *testMailer) tokenFromMail(t *testing.T, to string) string {
+	t.Helper()
+	match := mailTokenPattern.FindStringSubmatch(m.lastTo(t, to).Body)
+	if match == nil {
+		t.Fatalf("No token link in mail to %s", to)
+	}
+	return match[1]
+}
+
 // createTestHandler creates an OgenHandler with all services for testing
-func createTestHandler(db *gorm.DB) api.Handler {
-	authService := services.NewAuthService(db).Build()
-	userService := services.NewUserService(db).Build()
+func createTestHandler(db *gorm.DB) *services.OgenHandler {
+	return createTestHandlerWithMailer(db, &testMailer{})
+}
+
+// createTestHandlerWithMailer creates an OgenHandler whose mail goes to mailer
+func createTestHandlerWithMailer(db *gorm.DB, mailer services.Mailer) *services.OgenHandler {
+	authService := services.NewAuthService(db).
+		WithTokenService(createTestTokenService()).
+		Build()
+	userService := services.NewUserService(db).WithMailer(mailer).Build()
 	taskService := services.NewTaskService(db).Build()
 	appService := services.NewAppService(db).Build()
 	chatService := services.NewChatService(db).Build()
 	dashboardService := services.NewDashboardService().Build()
+	sessionService := services.NewSessionService(db).Build()
+	invitationService := services.NewInvitationService(db).WithMailer(mailer).Build()
+	passwordService := services.NewPasswordService(db).WithMailer(mailer).Build()
+	mfaService := services.NewMFAService(db).Build()
+	apiKeyService := services.NewAPIKeyService(db).Build()
+	loginHistoryService := services.NewLoginHistoryService(db).Build()
 
 	return services.NewOgenHandler().
 		WithAuthService(authService).
@@ -193,5 +281,105 @@ func createTestHandler(db *gorm.DB) api.Handler {
 		WithAppService(appService).
 		WithChatService(chatService).
 		WithDashboardService(dashboardService).
+		WithSessionService(sessionService).
+		WithInvitationService(invitationService).
+		WithPasswordService(passwordService).
+		WithMFAService(mfaService).
+		WithAPIKeyService(apiKeyService).
+		WithLoginHistoryService(loginHistoryService).
 		Build()
 }
+
+// createTestServer creates an ogen server with the production error and security handlers
+func createTestServer(t *testing.T, db *gorm.DB) *api.Server {
+	t.Helper()
+
+	server, err := handlers.NewServer(createTestHandler(db))
+	if err != nil {
+		t.Fatalf("Failed to create server: %v", err)
+	}
+	return server
+}
+
+// createTestServerWithMailer creates a test server that records sent mail
+func createTestServerWithMailer(t *testing.T, db *gorm.DB) (*api.Server, *testMailer) {
+	t.Helper()
+
+	mailer := &testMailer{}
+	server, err := handlers.NewServer(createTestHandlerWithMailer(db, mailer))
+	if err != nil {
+		t.Fatalf("Failed to create server: %v", err)
+	}
+	return server, mailer
+}
+
+// createAuthorizedTestServer creates a test server whose requests are
+// authenticated as a superadmin (admin@test.com) unless they set their own
+// Authorization header
+func createAuthorizedTestServer(t *testing.T, db *gorm.DB) http.Handler {
+	t.Helper()
+
+	admin := createTestUser(t, db, "admin@test.com", "password123", "superadmin")
+	return withBearerToken(createTestServer(t, db), createTestAccessToken(t, db, admin))
+}
+
+// createTestAccessToken issues an access token and session for the given user
+func createTestAccessToken(t *testing.T, db *gorm.DB, user *models.User) string {
+	t.Helper()
+
+	token, claims, err := createTestTokenService().Issue(services.TokenClaims{UserID: user.ID, Role: user.Role})
+	if err != nil {
+		t.Fatalf("Failed to issue access token: %v", err)
+	}
+
+	session := &models.Session{ID: claims.ID, UserID: user.ID, ExpiresAt: claims.ExpiresAt}
+	if err := db.Create(session).Error; err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+	return token
+}
+
+// withBearerToken sets the bearer token on requests that carry no Authorization header
+func withBearerToken(next http.Handler, token string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// loginTestUser logs in through the API and returns the login response
+func loginTestUser(t *testing.T, server http.Handler, email, password string) api.LoginResponse {
+	t.Helper()
+
+	req := &api.LoginRequest{Email: email, Password: password}
+	data, err := req.MarshalJSON()
+	if err != nil {
+		t.Fatalf("Failed to marshal login request: %v", err)
+	}
+
+	httpReq := httptest.NewRequest("POST", "/auth/login", bytes.NewReader(data))
+	httpReq.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	server.ServeHTTP(rec, httpReq)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Login failed with status %d. Body: %s", rec.Code, rec.Body.String())
+	}
+
+	var response api.LoginResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to unmarshal login response: %v", err)
+	}
+	return response
+}
+
+// doWithToken serves a request authenticated with the given bearer token
+func doWithToken(server http.Handler, req *http.Request, token string) *httptest.ResponseRecorder {
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+	return rec
+}
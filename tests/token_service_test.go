@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/sunfmin/shadcn-admin-go/services"
+)
+
+func TestTokenService(t *testing.T) {
+	oldKey := []byte("old-signing-key-0123456789abcdefg")
+	newKey := []byte("new-signing-key-0123456789abcdefg")
+	userID := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+
+	oldService, err := services.NewTokenService().WithKey("k1", oldKey).Build()
+	if err != nil {
+		t.Fatalf("Failed to build token service: %v", err)
+	}
+
+	// Rotated service signs with k2 but still accepts k1 tokens
+	rotatedService, err := services.NewTokenService().
+		WithKey("k2", newKey).
+		WithKey("k1", oldKey).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to build rotated token service: %v", err)
+	}
+
+	t.Run("issue and verify", func(t *testing.T) {
+		token, issued, err := oldService.Issue(services.TokenClaims{UserID: userID, Role: "admin"})
+		if err != nil {
+			t.Fatalf("Issue failed: %v", err)
+		}
+
+		claims, err := oldService.Verify(token)
+		if err != nil {
+			t.Fatalf("Verify failed: %v", err)
+		}
+
+		expected := services.TokenClaims{
+			ID:        issued.ID,
+			UserID:    userID,
+			Role:      "admin",
+			IssuedAt:  issued.IssuedAt.Truncate(time.Second),
+			ExpiresAt: issued.ExpiresAt.Truncate(time.Second),
+		}
+		opts := cmp.Options{cmpopts.EquateApproxTime(time.Second)}
+		if diff := cmp.Diff(expected, *claims, opts...); diff != "" {
+			t.Errorf("Claims mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("token signed with retired key still verifies", func(t *testing.T) {
+		token, _, err := oldService.Issue(services.TokenClaims{UserID: userID, Role: "cashier"})
+		if err != nil {
+			t.Fatalf("Issue failed: %v", err)
+		}
+		if _, err := rotatedService.Verify(token); err != nil {
+			t.Errorf("Expected rotated service to accept old token, got %v", err)
+		}
+	})
+
+	t.Run("token signed with unknown key is rejected", func(t *testing.T) {
+		token, _, err := rotatedService.Issue(services.TokenClaims{UserID: userID, Role: "cashier"})
+		if err != nil {
+			t.Fatalf("Issue failed: %v", err)
+		}
+		if _, err := oldService.Verify(token); !errors.Is(err, services.ErrInvalidToken) {
+			t.Errorf("Expected ErrInvalidToken, got %v", err)
+		}
+	})
+
+	t.Run("tampered payload is rejected", func(t *testing.T) {
+		token, _, err := oldService.Issue(services.TokenClaims{UserID: userID, Role: "cashier"})
+		if err != nil {
+			t.Fatalf("Issue failed: %v", err)
+		}
+		forged, _, err := oldService.Issue(services.TokenClaims{UserID: userID, Role: "superadmin"})
+		if err != nil {
+			t.Fatalf("Issue failed: %v", err)
+		}
+		parts := strings.Split(token, ".")
+		forgedParts := strings.Split(forged, ".")
+		tampered := parts[0] + "." + forgedParts[1] + "." + parts[2]
+
+		if _, err := oldService.Verify(tampered); !errors.Is(err, services.ErrInvalidToken) {
+			t.Errorf("Expected ErrInvalidToken, got %v", err)
+		}
+	})
+
+	t.Run("legacy token format is rejected", func(t *testing.T) {
+		legacy := "token_" + userID.String() + "_4102444800"
+		if _, err := oldService.Verify(legacy); !errors.Is(err, services.ErrInvalidToken) {
+			t.Errorf("Expected ErrInvalidToken, got %v", err)
+		}
+	})
+
+	t.Run("expired token is rejected", func(t *testing.T) {
+		token, _, err := oldService.Issue(services.TokenClaims{
+			UserID:    userID,
+			Role:      "admin",
+			ExpiresAt: time.Now().Add(-time.Minute),
+		})
+		if err != nil {
+			t.Fatalf("Issue failed: %v", err)
+		}
+		if _, err := oldService.Verify(token); !errors.Is(err, services.ErrTokenExpired) {
+			t.Errorf("Expected ErrTokenExpired, got %v", err)
+		}
+	})
+
+	t.Run("short keys are refused", func(t *testing.T) {
+		if _, err := services.NewTokenService().WithKey("k", []byte("short")).Build(); err == nil {
+			t.Error("Expected error for short signing key")
+		}
+	})
+}
@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+)
+
+func TestBulkUserAction(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "refresh_tokens")
+
+	root := createTestUser(t, db, "root@test.com", "password123", "superadmin")
+	other := createTestUser(t, db, "other@test.com", "password123", "superadmin")
+	admin := createTestUser(t, db, "admin@test.com", "password123", "admin")
+	alice := createTestUser(t, db, "alice@test.com", "password123", "cashier")
+	bob := createTestUser(t, db, "bob@test.com", "password123", "cashier")
+
+	server := createTestServer(t, db)
+	rootToken := createTestAccessToken(t, db, root)
+	adminToken := createTestAccessToken(t, db, admin)
+	aliceToken := createTestAccessToken(t, db, alice)
+
+	bulk := func(t *testing.T, token string, req *api.BulkUserActionRequest) *httptest.ResponseRecorder {
+		t.Helper()
+		return doWithToken(server, newAPIRequest(t, "POST", "/users/bulk", req), token)
+	}
+	userStatus := func(id uuid.UUID) (string, string) {
+		var u models.User
+		db.Unscoped().First(&u, "id = ?", id)
+		return u.Status, u.Role
+	}
+
+	t.Run("per-user results", func(t *testing.T) {
+		missing := uuid.New()
+		rec := bulk(t, adminToken, &api.BulkUserActionRequest{
+			UserIds: []uuid.UUID{alice.ID, bob.ID, other.ID, missing, alice.ID},
+			Action:  api.BulkUserActionSetStatus,
+			Status:  api.NewOptUserStatus(api.UserStatusSuspended),
+		})
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		var response api.BulkUserActionResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+
+		expected := []api.BulkUserActionResult{
+			{UserId: alice.ID, Result: api.BulkUserActionOutcomeUpdated},
+			{UserId: bob.ID, Result: api.BulkUserActionOutcomeUpdated},
+			{UserId: other.ID, Result: api.BulkUserActionOutcomeForbidden},
+			{UserId: missing, Result: api.BulkUserActionOutcomeNotFound},
+		}
+		if len(response.Results) != len(expected) {
+			t.Fatalf("Expected %d results, got %+v", len(expected), response.Results)
+		}
+		for i, e := range expected {
+			if got := response.Results[i]; got.UserId != e.UserId || got.Result != e.Result {
+				t.Errorf("Result %d: expected %s %s, got %s %s", i, e.UserId, e.Result, got.UserId, got.Result)
+			}
+		}
+
+		if status, _ := userStatus(alice.ID); status != "suspended" {
+			t.Errorf("Expected alice to be suspended, got %s", status)
+		}
+		if status, _ := userStatus(other.ID); status != "active" {
+			t.Errorf("Expected the superadmin to be left alone, got %s", status)
+		}
+		if rec := doWithToken(server, httptest.NewRequest("GET", "/auth/me", nil), aliceToken); rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected suspended users to be signed out, got %d", rec.Code)
+		}
+	})
+
+	t.Run("role changes", func(t *testing.T) {
+		rec := bulk(t, adminToken, &api.BulkUserActionRequest{UserIds: []uuid.UUID{alice.ID}, Action: api.BulkUserActionSetRole, Role: api.NewOptUserRole(api.UserRoleSuperadmin)})
+		assertErrorCode(t, rec, http.StatusForbidden, handlers.Errors.Forbidden.Code)
+
+		rec = bulk(t, adminToken, &api.BulkUserActionRequest{UserIds: []uuid.UUID{alice.ID, bob.ID}, Action: api.BulkUserActionSetRole, Role: api.NewOptUserRole(api.UserRoleManager)})
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		if _, role := userStatus(bob.ID); role != "manager" {
+			t.Errorf("Expected bob to be a manager, got %s", role)
+		}
+	})
+
+	t.Run("the action must be complete", func(t *testing.T) {
+		rec := bulk(t, adminToken, &api.BulkUserActionRequest{UserIds: []uuid.UUID{alice.ID}, Action: api.BulkUserActionSetStatus})
+		assertErrorCode(t, rec, http.StatusBadRequest, handlers.Errors.InvalidBulkAction.Code)
+	})
+
+	t.Run("the last superadmin is kept", func(t *testing.T) {
+		rec := bulk(t, rootToken, &api.BulkUserActionRequest{UserIds: []uuid.UUID{root.ID, other.ID}, Action: api.BulkUserActionDelete})
+		assertErrorCode(t, rec, http.StatusConflict, handlers.Errors.LastSuperadmin.Code)
+		if status, _ := userStatus(other.ID); status != "active" {
+			t.Error("Expected a refused request to change nothing")
+		}
+
+		rec = bulk(t, rootToken, &api.BulkUserActionRequest{UserIds: []uuid.UUID{other.ID}, Action: api.BulkUserActionDelete})
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+
+		rec = bulk(t, rootToken, &api.BulkUserActionRequest{UserIds: []uuid.UUID{root.ID}, Action: api.BulkUserActionSetRole, Role: api.NewOptUserRole(api.UserRoleAdmin)})
+		assertErrorCode(t, rec, http.StatusConflict, handlers.Errors.LastSuperadmin.Code)
+		if _, role := userStatus(root.ID); role != "superadmin" {
+			t.Errorf("Expected the last superadmin to keep the role, got %s", role)
+		}
+	})
+
+	t.Run("cashiers cannot use bulk actions", func(t *testing.T) {
+		cashier := createTestUser(t, db, "carol@test.com", "password123", "cashier")
+		rec := bulk(t, createTestAccessToken(t, db, cashier), &api.BulkUserActionRequest{UserIds: []uuid.UUID{bob.ID}, Action: api.BulkUserActionDelete})
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("Expected status %d, got %d", http.StatusForbidden, rec.Code)
+		}
+	})
+}
+
+func TestLastSuperadminSingleUser(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "refresh_tokens")
+
+	root := createTestUser(t, db, "root@test.com", "password123", "superadmin")
+	server := createTestServer(t, db)
+	rootToken := createTestAccessToken(t, db, root)
+	path := "/users/" + root.ID.String()
+
+	for name, req := range map[string]*api.UpdateUserRequest{
+		"demote":  {Role: api.NewOptUserRole(api.UserRoleAdmin)},
+		"suspend": {Status: api.NewOptUserStatus(api.UserStatusSuspended)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			rec := doWithToken(server, newAPIRequest(t, "PUT", path, req), rootToken)
+			assertErrorCode(t, rec, http.StatusConflict, handlers.Errors.LastSuperadmin.Code)
+		})
+	}
+
+	t.Run("delete", func(t *testing.T) {
+		rec := doWithToken(server, httptest.NewRequest("DELETE", path, nil), rootToken)
+		assertErrorCode(t, rec, http.StatusConflict, handlers.Errors.LastSuperadmin.Code)
+	})
+
+	var u models.User
+	db.First(&u, "id = ?", root.ID)
+	if u.Role != "superadmin" || u.Status != "active" {
+		t.Errorf("Expected the last superadmin to be left alone, got %s %s", u.Role, u.Status)
+	}
+
+	t.Run("allowed once another superadmin exists", func(t *testing.T) {
+		createTestUser(t, db, "other@test.com", "password123", "superadmin")
+		req := newAPIRequest(t, "PUT", path, &api.UpdateUserRequest{Role: api.NewOptUserRole(api.UserRoleAdmin)})
+		if rec := doWithToken(server, req, rootToken); rec.Code != http.StatusOK {
+			t.Errorf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+	})
+}
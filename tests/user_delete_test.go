@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+)
+
+func TestUserSoftDelete(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "refresh_tokens", "login_events")
+
+	superadmin := createTestUser(t, db, "root@test.com", "password123", "superadmin")
+	admin := createTestUser(t, db, "admin@test.com", "password123", "admin")
+	manager := createTestUser(t, db, "manager@test.com", "password123", "manager")
+	alice := createTestUser(t, db, "alice@test.com", "password123", "cashier")
+
+	server := createTestServer(t, db)
+	rootToken := createTestAccessToken(t, db, superadmin)
+	adminToken := createTestAccessToken(t, db, admin)
+	aliceToken := createTestAccessToken(t, db, alice)
+	aliceURL := "/users/" + alice.ID.String()
+
+	getUser := func(t *testing.T, path, token string) (*httptest.ResponseRecorder, api.User) {
+		t.Helper()
+		rec := doWithToken(server, httptest.NewRequest("GET", path, nil), token)
+		var user api.User
+		json.Unmarshal(rec.Body.Bytes(), &user)
+		return rec, user
+	}
+	listed := func(t *testing.T, query string) bool {
+		t.Helper()
+		rec := doWithToken(server, httptest.NewRequest("GET", "/users"+query, nil), adminToken)
+		var response api.UserListResponse
+		json.Unmarshal(rec.Body.Bytes(), &response)
+		for _, u := range response.Data {
+			if u.ID == alice.ID {
+				return true
+			}
+		}
+		return false
+	}
+	createAlice := func(t *testing.T) *httptest.ResponseRecorder {
+		t.Helper()
+		req := &api.CreateUserRequest{FirstName: "Alice", LastName: "Again", Email: "alice@test.com", Role: api.UserRoleCashier, Password: api.NewOptString("Corr3ct-Horse-Battery")}
+		return doWithToken(server, newAPIRequest(t, "POST", "/users", req), adminToken)
+	}
+
+	if rec := doWithToken(server, httptest.NewRequest("DELETE", aliceURL, nil), adminToken); rec.Code != http.StatusNoContent {
+		t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusNoContent, rec.Code, rec.Body.String())
+	}
+
+	t.Run("deleted users are hidden and signed out", func(t *testing.T) {
+		if rec, _ := getUser(t, aliceURL, adminToken); rec.Code != http.StatusNotFound {
+			t.Errorf("Expected status %d, got %d", http.StatusNotFound, rec.Code)
+		}
+		if listed(t, "") {
+			t.Error("Expected the deleted user to be left out of the list")
+		}
+		if rec, _ := getUser(t, "/auth/me", aliceToken); rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected the deleted user's token to be rejected, got %d", rec.Code)
+		}
+		rec := doWithToken(server, newAPIRequest(t, "POST", "/auth/login", &api.LoginRequest{Email: "alice@test.com", Password: "password123"}), "")
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected the deleted user to be unable to log in, got %d", rec.Code)
+		}
+	})
+
+	t.Run("admins can include deleted users", func(t *testing.T) {
+		rec, user := getUser(t, aliceURL+"?includeDeleted=true", adminToken)
+		if rec.Code != http.StatusOK || !user.DeletedAt.IsSet() {
+			t.Errorf("Expected the deleted user with deletedAt, got %d %+v", rec.Code, user)
+		}
+		if !listed(t, "?includeDeleted=true") {
+			t.Error("Expected includeDeleted to list the deleted user")
+		}
+		if !listed(t, "?includeDeleted=true&q=alice") {
+			t.Error("Expected includeDeleted to apply to searches")
+		}
+
+		rec, _ = getUser(t, aliceURL+"?includeDeleted=true", createTestAccessToken(t, db, manager))
+		assertErrorCode(t, rec, http.StatusForbidden, handlers.Errors.Forbidden.Code)
+	})
+
+	t.Run("the email stays reserved until purged", func(t *testing.T) {
+		rec := createAlice(t)
+		assertErrorCode(t, rec, http.StatusConflict, handlers.Errors.DuplicateEmail.Code)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		rec := doWithToken(server, httptest.NewRequest("POST", aliceURL+"/restore", nil), adminToken)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		var user api.User
+		json.Unmarshal(rec.Body.Bytes(), &user)
+		if user.DeletedAt.IsSet() || !listed(t, "") {
+			t.Errorf("Expected the user to be active again, got %+v", user)
+		}
+
+		rec = doWithToken(server, newAPIRequest(t, "POST", "/auth/login", &api.LoginRequest{Email: "alice@test.com", Password: "password123"}), "")
+		if rec.Code != http.StatusOK {
+			t.Errorf("Expected the restored user to log in, got %d", rec.Code)
+		}
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		rec := doWithToken(server, httptest.NewRequest("DELETE", aliceURL+"/purge", nil), rootToken)
+		assertErrorCode(t, rec, http.StatusConflict, handlers.Errors.UserNotDeleted.Code)
+
+		doWithToken(server, httptest.NewRequest("DELETE", aliceURL, nil), adminToken)
+		if rec := doWithToken(server, httptest.NewRequest("DELETE", aliceURL+"/purge", nil), adminToken); rec.Code != http.StatusForbidden {
+			t.Errorf("Expected admins to be unable to purge, got %d", rec.Code)
+		}
+		if rec := doWithToken(server, httptest.NewRequest("DELETE", aliceURL+"/purge", nil), rootToken); rec.Code != http.StatusNoContent {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusNoContent, rec.Code, rec.Body.String())
+		}
+
+		if rec, _ := getUser(t, aliceURL+"?includeDeleted=true", adminToken); rec.Code != http.StatusNotFound {
+			t.Errorf("Expected the purged user to be gone, got %d", rec.Code)
+		}
+		if rec := createAlice(t); rec.Code != http.StatusCreated {
+			t.Errorf("Expected the email to be reusable after purge, got %d. Body: %s", rec.Code, rec.Body.String())
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestUserExport(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions")
+
+	server := createAuthorizedTestServer(t, db)
+	createTestUser(t, db, "alice@test.com", "password123", "cashier")
+	createTestUser(t, db, "bob@test.com", "password123", "manager")
+	db.Exec("UPDATE users SET first_name = ? WHERE username = ?", "=HYPERLINK(\"http://evil\")", "bob")
+
+	export := func(t *testing.T, query string) *httptest.ResponseRecorder {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest("GET", "/users/export"+query, nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		return rec
+	}
+
+	t.Run("csv honours filters", func(t *testing.T) {
+		rec := export(t, "?role=cashier&role=manager")
+		if got := rec.Header().Get("Content-Type"); got != "text/csv" {
+			t.Errorf("Expected text/csv, got %q", got)
+		}
+		if got := rec.Header().Get("Content-Disposition"); !strings.HasPrefix(got, "attachment;") || !strings.HasSuffix(got, `.csv"`) {
+			t.Errorf("Unexpected Content-Disposition %q", got)
+		}
+
+		records, err := csv.NewReader(rec.Body).ReadAll()
+		if err != nil {
+			t.Fatalf("Failed to parse CSV: %v", err)
+		}
+		header := records[0]
+		if slices.Contains(header, "password") || !slices.Contains(header, "createdAt") || !slices.Contains(header, "updatedAt") {
+			t.Errorf("Unexpected header %v", header)
+		}
+		if len(records) != 3 {
+			t.Fatalf("Expected the two matching users, got %v", records[1:])
+		}
+		username := slices.Index(header, "username")
+		firstName := slices.Index(header, "firstName")
+		for _, r := range records[1:] {
+			if r[username] == "admin" {
+				t.Error("Expected the superadmin to be filtered out")
+			}
+			if r[username] == "bob" && !strings.HasPrefix(r[firstName], "'") {
+				t.Errorf("Expected formulas to be neutralised, got %q", r[firstName])
+			}
+		}
+		if strings.Contains(rec.Body.String(), "$2a$") {
+			t.Error("Expected password hashes to be left out")
+		}
+	})
+
+	t.Run("xlsx", func(t *testing.T) {
+		rec := export(t, "?format=xlsx&username=ali")
+		if got := rec.Header().Get("Content-Type"); got != "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet" {
+			t.Errorf("Unexpected Content-Type %q", got)
+		}
+
+		body := rec.Body.Bytes()
+		archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+		if err != nil {
+			t.Fatalf("Expected a zip archive: %v", err)
+		}
+		f, err := archive.Open("xl/worksheets/sheet1.xml")
+		if err != nil {
+			t.Fatalf("Expected a worksheet: %v", err)
+		}
+		sheet, _ := io.ReadAll(f)
+		if !strings.Contains(string(sheet), "alice@test.com") || strings.Contains(string(sheet), "bob@test.com") {
+			t.Errorf("Expected only alice in the sheet, got %s", sheet)
+		}
+	})
+
+	t.Run("cashiers cannot export", func(t *testing.T) {
+		cashier := createTestUser(t, db, "carol@test.com", "password123", "cashier")
+		req := httptest.NewRequest("GET", "/users/export", nil)
+		rec := doWithToken(createTestServer(t, db), req, createTestAccessToken(t, db, cashier))
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("Expected status %d, got %d", http.StatusForbidden, rec.Code)
+		}
+	})
+}
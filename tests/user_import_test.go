@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"testing"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+	"github.com/sunfmin/shadcn-admin-go/internal/models"
+)
+
+// newImportRequest builds a multipart user import request for the CSV
+func newImportRequest(t *testing.T, csv string, dryRun, sendInvitations bool) *http.Request {
+	t.Helper()
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("file", "users.csv")
+	if err != nil {
+		t.Fatalf("Failed to create form file: %v", err)
+	}
+	part.Write([]byte(csv))
+	w.WriteField("dryRun", strconv.FormatBool(dryRun))
+	w.WriteField("sendInvitations", strconv.FormatBool(sendInvitations))
+	w.Close()
+
+	req, err := http.NewRequest("POST", "/users/import", &body)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	return req
+}
+
+// importUsers posts the CSV and returns the import report
+func importUsers(t *testing.T, server http.Handler, token, csv string, dryRun, sendInvitations bool) api.UserImportReport {
+	t.Helper()
+	rec := doWithToken(server, newImportRequest(t, csv, dryRun, sendInvitations), token)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	var report api.UserImportReport
+	json.Unmarshal(rec.Body.Bytes(), &report)
+	return report
+}
+
+func TestUserImport(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "password_resets", "invitations", "login_events")
+
+	admin := createTestUser(t, db, "admin@test.com", "password123", "admin")
+	server, mailer := createTestServerWithMailer(t, db)
+	adminToken := createTestAccessToken(t, db, admin)
+
+	csv := "email,firstName,lastName,role,password,phoneNumber\n" +
+		"ann@test.com,Ann,Lee,cashier,Corr3ct-Horse-Battery,+1 555 0100\n" +
+		"ben@test.com,Ben,Ng,manager,,\n" +
+		"not-an-email,Cat,Poe,cashier,,\n" +
+		"ann@test.com,Ann,Again,cashier,,\n" +
+		"ADMIN@test.com,Dup,Admin,cashier,,\n" +
+		"dan@test.com,Dan,Ito,owner,,\n" +
+		"eve@test.com,,Ray,cashier,,\n"
+
+	t.Run("dry run reports every problem and creates nothing", func(t *testing.T) {
+		report := importUsers(t, server, adminToken, csv, true, false)
+		if !report.DryRun || report.Total != 7 || report.Valid != 2 || report.Created != 0 {
+			t.Errorf("Unexpected report %+v", report)
+		}
+
+		expected := []struct {
+			row   int
+			field string
+		}{{4, "email"}, {5, "email"}, {6, "email"}, {7, "role"}, {8, "firstName"}}
+		if len(report.Errors) != len(expected) {
+			t.Fatalf("Expected %d errors, got %+v", len(expected), report.Errors)
+		}
+		for i, e := range expected {
+			if report.Errors[i].Row != e.row || report.Errors[i].Field.Or("") != e.field {
+				t.Errorf("Expected error on row %d field %s, got %+v", e.row, e.field, report.Errors[i])
+			}
+		}
+
+		var count int64
+		db.Model(&models.User{}).Count(&count)
+		if count != 1 {
+			t.Errorf("Expected dry run to create no users, found %d", count)
+		}
+	})
+
+	t.Run("valid rows are created", func(t *testing.T) {
+		report := importUsers(t, server, adminToken, csv, false, false)
+		if report.Created != 2 || len(report.Users) != 2 || len(report.Errors) != 5 {
+			t.Fatalf("Unexpected report %+v", report)
+		}
+
+		rec := doWithToken(server, newAPIRequest(t, "POST", "/auth/login", &api.LoginRequest{Email: "ann@test.com", Password: "Corr3ct-Horse-Battery"}), "")
+		if rec.Code != http.StatusOK {
+			t.Errorf("Expected imported password to log in, got %d", rec.Code)
+		}
+		// Rows without a password are sent a link to choose one
+		mailer.tokenFromMail(t, "ben@test.com")
+
+		// Importing the same file again finds everyone already present
+		report = importUsers(t, server, adminToken, csv, false, false)
+		if report.Created != 0 || report.Valid != 0 {
+			t.Errorf("Expected no users to be created twice, got %+v", report)
+		}
+	})
+
+	t.Run("invitations", func(t *testing.T) {
+		report := importUsers(t, server, adminToken, "email,role\nfay@test.com,cashier\n", false, true)
+		if report.Created != 1 || report.Users[0].Status != api.UserStatusInvited {
+			t.Fatalf("Unexpected report %+v", report)
+		}
+		mailer.tokenFromMail(t, "fay@test.com")
+
+		report = importUsers(t, server, adminToken, "email,role,password\ngus@test.com,cashier,Corr3ct-Horse-Battery\n", true, true)
+		if report.Valid != 0 || len(report.Errors) != 1 || report.Errors[0].Field.Or("") != "password" {
+			t.Errorf("Expected passwords to be rejected with invitations, got %+v", report)
+		}
+	})
+
+	t.Run("mail is sent after the users are created", func(t *testing.T) {
+		mailer.setFailing(true)
+		defer mailer.setFailing(false)
+
+		report := importUsers(t, server, adminToken, "email,role\nhal@test.com,cashier\nivy@test.com,cashier\n", false, true)
+		if report.Created != 2 || len(report.Errors) != 2 {
+			t.Fatalf("Expected both users created with a delivery error each, got %+v", report)
+		}
+		for i, e := range report.Errors {
+			if e.Row != i+2 || e.Field.Or("") != "email" {
+				t.Errorf("Unexpected delivery error %+v", e)
+			}
+		}
+	})
+
+	t.Run("unusable files", func(t *testing.T) {
+		for _, csv := range []string{"", "email,nickname\na@test.com,A\n", "firstName,lastName\nA,B\n"} {
+			rec := doWithToken(server, newImportRequest(t, csv, true, false), adminToken)
+			assertErrorCode(t, rec, http.StatusBadRequest, handlers.Errors.InvalidImport.Code)
+		}
+	})
+
+	t.Run("cashiers cannot import", func(t *testing.T) {
+		cashier := createTestUser(t, db, "cashier@test.com", "password123", "cashier")
+		rec := doWithToken(server, newImportRequest(t, "email,role\nhal@test.com,cashier\n", true, false), createTestAccessToken(t, db, cashier))
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("Expected status %d, got %d", http.StatusForbidden, rec.Code)
+		}
+	})
+}
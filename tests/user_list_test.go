@@ -0,0 +1,153 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"testing"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+)
+
+// listUsers fetches /users with the given query and returns the decoded page
+func listUsers(t *testing.T, server http.Handler, query string) api.UserListResponse {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest("GET", "/users"+query, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	var response api.UserListResponse
+	json.Unmarshal(rec.Body.Bytes(), &response)
+	return response
+}
+
+// usernames returns the usernames of the listed users in order
+func usernames(users []api.User) []string {
+	names := make([]string, len(users))
+	for i, u := range users {
+		names[i] = u.Username
+	}
+	return names
+}
+
+func TestUserSort(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions")
+
+	server := createAuthorizedTestServer(t, db)
+	for _, u := range []struct{ email, lastName string }{
+		{"carol@test.com", "Baker"},
+		{"alice@test.com", "Adams"},
+		{"bob@test.com", "Baker"},
+		{"dave@test.com", "Clark"},
+	} {
+		user := createTestUser(t, db, u.email, "password123", "cashier")
+		db.Model(user).Update("last_name", u.lastName)
+	}
+	db.Exec("UPDATE users SET last_name = 'Zed' WHERE email = 'admin@test.com'")
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{name: "single field", query: "?sort=username", expected: []string{"admin", "alice", "bob", "carol", "dave"}},
+		{name: "descending", query: "?sort=-username", expected: []string{"dave", "carol", "bob", "alice", "admin"}},
+		{name: "multiple fields", query: "?sort=lastName,-username", expected: []string{"alice", "carol", "bob", "dave", "admin"}},
+		{name: "with filters", query: "?sort=-lastName,username&role=cashier", expected: []string{"dave", "bob", "carol", "alice"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := usernames(listUsers(t, server, tc.query).Data)
+			if len(got) != len(tc.expected) {
+				t.Fatalf("Expected %v, got %v", tc.expected, got)
+			}
+			for i := range got {
+				if got[i] != tc.expected[i] {
+					t.Fatalf("Expected %v, got %v", tc.expected, got)
+				}
+			}
+		})
+	}
+
+	t.Run("pages neither skip nor repeat users with equal sort values", func(t *testing.T) {
+		db.Exec("UPDATE users SET last_name = 'Same'")
+		defer db.Exec("UPDATE users SET last_name = 'Zed' WHERE email = 'admin@test.com'")
+
+		seen := make(map[string]bool)
+		for page := 1; page <= 3; page++ {
+			for _, name := range usernames(listUsers(t, server, "?sort=lastName&pageSize=2&page="+strconv.Itoa(page)).Data) {
+				if seen[name] {
+					t.Errorf("User %s appeared on more than one page", name)
+				}
+				seen[name] = true
+			}
+		}
+		if len(seen) != 5 {
+			t.Errorf("Expected all 5 users across the pages, got %d", len(seen))
+		}
+	})
+
+	t.Run("unsupported sort fields are rejected", func(t *testing.T) {
+		for _, query := range []string{"?sort=password", "?sort=username,-username", "?sort=first_name"} {
+			rec := httptest.NewRecorder()
+			server.ServeHTTP(rec, httptest.NewRequest("GET", "/users"+query, nil))
+			assertErrorCode(t, rec, http.StatusBadRequest, handlers.Errors.InvalidSort.Code)
+		}
+	})
+}
+
+func TestUserSearch(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions")
+
+	server := createAuthorizedTestServer(t, db)
+	for _, u := range []struct{ email, lastName, phone string }{
+		{"jordan@test.com", "Smith", "+1 555 0100"},
+		{"smith@test.com", "Jones", ""},
+		{"smithers@test.com", "Burns", ""},
+		{"lee@test.com", "Goldsmith", "+1 555 0199"},
+		{"pat_1@test.com", "Brown", ""},
+		{"patx@test.com", "Gray", ""},
+	} {
+		user := createTestUser(t, db, u.email, "password123", "cashier")
+		db.Model(user).Updates(map[string]interface{}{"last_name": u.lastName, "phone_number": u.phone})
+	}
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{name: "exact email", query: "?q=smith@test.com&sort=username", expected: []string{"smith"}},
+		{name: "ranked by match quality", query: "?q=smith&sort=username", expected: []string{"smith", "jordan", "smithers", "lee"}},
+		{name: "case insensitive", query: "?q=GOLDSMITH", expected: []string{"lee"}},
+		{name: "phone number", query: "?q=555%200199", expected: []string{"lee"}},
+		{name: "wildcards match literally", query: "?q=pat_", expected: []string{"pat_1"}},
+		{name: "combined with filters", query: "?q=smith&role=admin", expected: []string{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := usernames(listUsers(t, server, tc.query).Data)
+			if !slices.Equal(got, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+
+	t.Run("cursor pages follow the ranking", func(t *testing.T) {
+		first := listUsers(t, server, "?q=smith&sort=username&pageSize=2")
+		second := listUsers(t, server, "?q=smith&sort=username&pageSize=2&cursor="+url.QueryEscape(first.Cursors.Value.Next.Value))
+		got := append(usernames(first.Data), usernames(second.Data)...)
+		if expected := []string{"smith", "jordan", "smithers", "lee"}; !slices.Equal(got, expected) {
+			t.Errorf("Expected %v, got %v", expected, got)
+		}
+	})
+}
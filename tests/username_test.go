@@ -0,0 +1,123 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	api "github.com/sunfmin/shadcn-admin-go/api/gen/admin"
+	"github.com/sunfmin/shadcn-admin-go/handlers"
+)
+
+func TestUsernameGeneration(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "password_resets", "invitations")
+
+	server := createAuthorizedTestServer(t, db)
+
+	post := func(t *testing.T, path string, body ogenEncoder) *httptest.ResponseRecorder {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, newAPIRequest(t, "POST", path, body))
+		return rec
+	}
+	created := func(t *testing.T, rec *httptest.ResponseRecorder) api.User {
+		t.Helper()
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("Expected status %d, got %d. Body: %s", http.StatusCreated, rec.Code, rec.Body.String())
+		}
+		var user api.User
+		json.Unmarshal(rec.Body.Bytes(), &user)
+		return user
+	}
+	createReq := func(email string) *api.CreateUserRequest {
+		return &api.CreateUserRequest{FirstName: "John", LastName: "Doe", Email: email, Role: api.UserRoleCashier}
+	}
+
+	t.Run("the same local part gets a suffix", func(t *testing.T) {
+		if user := created(t, post(t, "/users", createReq("john@a.com"))); user.Username != "john" {
+			t.Errorf("Expected username john, got %s", user.Username)
+		}
+		if user := created(t, post(t, "/users", createReq("john@b.com"))); user.Username != "john2" {
+			t.Errorf("Expected username john2, got %s", user.Username)
+		}
+		invite := &api.InviteUserRequest{Email: "john@c.com", Role: api.UserRoleCashier}
+		if user := created(t, post(t, "/users/invite", invite)); user.Username != "john3" {
+			t.Errorf("Expected username john3, got %s", user.Username)
+		}
+	})
+
+	t.Run("long local parts leave room for the suffix", func(t *testing.T) {
+		local := strings.Repeat("a", 70)
+		first := created(t, post(t, "/users", createReq(local+"@a.com")))
+		second := created(t, post(t, "/users", createReq(local+"@b.com")))
+		if first.Username != local[:60] || second.Username != local[:60]+"2" {
+			t.Errorf("Expected usernames %s and %s2, got %s and %s", local[:60], local[:60], first.Username, second.Username)
+		}
+	})
+
+	t.Run("a chosen username is kept", func(t *testing.T) {
+		req := createReq("jd@d.com")
+		req.Username = api.NewOptString("johnny")
+		if user := created(t, post(t, "/users", req)); user.Username != "johnny" {
+			t.Errorf("Expected username johnny, got %s", user.Username)
+		}
+	})
+
+	t.Run("conflicts report the duplicated field", func(t *testing.T) {
+		req := createReq("other@e.com")
+		req.Username = api.NewOptString("john")
+		assertErrorCode(t, post(t, "/users", req), http.StatusConflict, handlers.Errors.DuplicateUsername.Code)
+
+		invite := &api.InviteUserRequest{Email: "new@e.com", Role: api.UserRoleCashier, Username: api.NewOptString("john2")}
+		assertErrorCode(t, post(t, "/users/invite", invite), http.StatusConflict, handlers.Errors.DuplicateUsername.Code)
+
+		assertErrorCode(t, post(t, "/users", createReq("john@a.com")), http.StatusConflict, handlers.Errors.DuplicateEmail.Code)
+	})
+}
+
+func TestUserImportUsernames(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer truncateTables(db, "users", "sessions", "password_resets", "invitations", "login_events")
+
+	admin := createTestUser(t, db, "admin@test.com", "password123", "admin")
+	createTestUser(t, db, "ann@old.com", "password123", "cashier")
+	server, _ := createTestServerWithMailer(t, db)
+	adminToken := createTestAccessToken(t, db, admin)
+
+	csv := "email,username,firstName,lastName,role\n" +
+		"ann@a.com,,Ann,Lee,cashier\n" +
+		"ann@b.com,ann2,Ann,Park,cashier\n" +
+		"ann@c.com,,Ann,Kim,cashier\n" +
+		"bad@test.com,not valid,Bad,Name,cashier\n" +
+		"taken@test.com,admin,Taken,Name,cashier\n"
+	report := importUsers(t, server, adminToken, csv, false, false)
+
+	if report.Created != 3 {
+		t.Fatalf("Expected 3 users to be created, got %d: %+v", report.Created, report.Errors)
+	}
+	var got []string
+	for _, u := range report.Users {
+		got = append(got, u.Username)
+	}
+	// The chosen ann2 is inserted first, so generated names skip it
+	expected := []string{"ann3", "ann2", "ann4"}
+	for i := range expected {
+		if i >= len(got) || got[i] != expected[i] {
+			t.Fatalf("Expected usernames %v, got %v", expected, got)
+		}
+	}
+
+	if len(report.Errors) != 2 {
+		t.Fatalf("Expected 2 errors, got %+v", report.Errors)
+	}
+	for i, line := range []int{5, 6} {
+		if e := report.Errors[i]; e.Row != line || e.Field.Or("") != "username" {
+			t.Errorf("Expected a username error on row %d, got %+v", line, e)
+		}
+	}
+}